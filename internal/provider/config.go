@@ -29,6 +29,11 @@ type Config struct {
 	SnowflakeSchema         string
 	SnowflakePrivateKey     string
 	SnowflakePrivateKeyPath string
+
+	// SnowflakeEdition is the edition of the account, such as ENTERPRISE, or
+	// empty when it is not known. Resources use it to reject features the
+	// edition lacks at plan time.
+	SnowflakeEdition string
 }
 
 // NewConfig creates a new Config instance.
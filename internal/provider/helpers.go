@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ovh/go-ovh/ovh"
+
+	"github.com/swcstudio/terraform-provider-ovh.snowflake/internal/sdk/identifiers"
+)
+
+// isNotFoundError reports whether err is an OVH API error with a 404 status.
+func isNotFoundError(err error) bool {
+	var apiErr *ovh.APIError
+	return errors.As(err, &apiErr) && apiErr.Code == http.StatusNotFound
+}
+
+// apiString returns the string value stored under key in an OVH API response.
+func apiString(obj map[string]interface{}, key string) types.String {
+	v, ok := obj[key].(string)
+	if !ok {
+		return types.StringNull()
+	}
+	return types.StringValue(v)
+}
+
+// apiIdentifier is like apiString for object identifiers. It keeps the prior
+// value when it names the same object as the API response, so that a
+// configured analytics.public.orders is not replaced by the
+// ANALYTICS.PUBLIC.ORDERS spelling Snowflake reports.
+func apiIdentifier(obj map[string]interface{}, key string, prior types.String) types.String {
+	value := apiString(obj, key)
+	if value.IsNull() || prior.IsNull() || prior.IsUnknown() {
+		return value
+	}
+
+	priorNames, err := identifiers.ParseIdentifier(prior.ValueString())
+	if err != nil {
+		return value
+	}
+	names, err := identifiers.ParseIdentifier(value.ValueString())
+	if err != nil || !slices.Equal(names, priorNames) {
+		return value
+	}
+	return prior
+}
+
+// apiOptionalString is like apiString but treats empty strings as unset, for
+// optional attributes the API reports as "" when they were never configured.
+func apiOptionalString(obj map[string]interface{}, key string) types.String {
+	v, ok := obj[key].(string)
+	if !ok || v == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(v)
+}
+
+// apiBool returns the boolean value stored under key in an OVH API response.
+func apiBool(obj map[string]interface{}, key string) types.Bool {
+	v, ok := obj[key].(bool)
+	if !ok {
+		return types.BoolNull()
+	}
+	return types.BoolValue(v)
+}
+
+// apiInt64 returns the integer value stored under key in an OVH API response.
+// The OVH client decodes numbers as json.Number.
+func apiInt64(obj map[string]interface{}, key string) types.Int64 {
+	switch v := obj[key].(type) {
+	case json.Number:
+		i, err := v.Int64()
+		if err != nil {
+			return types.Int64Null()
+		}
+		return types.Int64Value(i)
+	case float64:
+		return types.Int64Value(int64(v))
+	default:
+		return types.Int64Null()
+	}
+}
+
+// apiStringList returns the list of strings stored under key in an OVH API
+// response, or nil when the key is absent.
+func apiStringList(obj map[string]interface{}, key string) []types.String {
+	raw, ok := obj[key].([]interface{})
+	if !ok {
+		return nil
+	}
+	values := make([]types.String, 0, len(raw))
+	for _, item := range raw {
+		if s, ok := item.(string); ok {
+			values = append(values, types.StringValue(s))
+		}
+	}
+	return values
+}
+
+// stringValues converts a list of framework strings to a plain string slice,
+// skipping null and unknown elements.
+func stringValues(values []types.String) []string {
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		if v.IsNull() || v.IsUnknown() {
+			continue
+		}
+		result = append(result, v.ValueString())
+	}
+	return result
+}
+
+// stringInSlice reports whether value is one of values.
+func stringInSlice(value string, values []string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// oneOfValidator accepts one of a fixed set of upper-case values.
+type oneOfValidator struct {
+	values []string
+}
+
+func (v oneOfValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be one of: %s", strings.Join(v.values, ", "))
+}
+
+func (v oneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v oneOfValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if !stringInSlice(req.ConfigValue.ValueString(), v.values) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("%q is not valid: %s.", req.ConfigValue.ValueString(), v.Description(ctx)),
+		)
+	}
+}
+
+// int64RangeValidator checks that an integer lies within [min, max].
+type int64RangeValidator struct {
+	min, max int64
+}
+
+func (v int64RangeValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be between %d and %d", v.min, v.max)
+}
+
+func (v int64RangeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v int64RangeValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if value := req.ConfigValue.ValueInt64(); value < v.min || value > v.max {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("%d is not valid: %s.", value, v.Description(ctx)),
+		)
+	}
+}
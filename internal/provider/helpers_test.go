@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ovh/go-ovh/ovh"
+)
+
+// fakeOVHError answers a call to the fake OVH API with an error status.
+type fakeOVHError int
+
+// fakeOVHAPI stands in for the OVH API in unit tests. It records every call
+// as "METHOD path", with the query string, and answers it from the route of
+// the same name. A route is a value sent back as JSON, a fakeOVHError, or a
+// func(body map[string]interface{}) interface{} that computes either from the
+// request body. Calls without a route get a 404.
+type fakeOVHAPI struct {
+	mu     sync.Mutex
+	routes map[string]interface{}
+	calls  []string
+	bodies map[string]map[string]interface{}
+}
+
+// newFakeOVHAPI starts a fake OVH API with the given routes and returns it
+// with a provider configuration whose OVH client talks to it.
+func newFakeOVHAPI(t *testing.T, routes map[string]interface{}) (*fakeOVHAPI, *Config) {
+	t.Helper()
+
+	api := &fakeOVHAPI{routes: routes, bodies: map[string]map[string]interface{}{}}
+	if api.routes == nil {
+		api.routes = map[string]interface{}{}
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(api.serve))
+	t.Cleanup(server.Close)
+
+	client, err := ovh.NewClient(server.URL, "key", "secret", "consumer")
+	if err != nil {
+		t.Fatalf("creating OVH client: %s", err)
+	}
+	return api, &Config{OVHClient: client}
+}
+
+// route sets the answer to call, e.g. "GET /cloud/project/snowflake/warehouse/1".
+func (a *fakeOVHAPI) route(call string, answer interface{}) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.routes[call] = answer
+}
+
+// recorded returns the calls received so far, in order.
+func (a *fakeOVHAPI) recorded() []string {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return append([]string(nil), a.calls...)
+}
+
+// body returns the body of the last call of the given name.
+func (a *fakeOVHAPI) body(call string) map[string]interface{} {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.bodies[call]
+}
+
+func (a *fakeOVHAPI) serve(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/auth/time" {
+		fmt.Fprint(w, time.Now().Unix())
+		return
+	}
+
+	call := r.Method + " " + r.URL.RequestURI()
+	var body map[string]interface{}
+	if r.Body != nil {
+		decoder := json.NewDecoder(r.Body)
+		decoder.UseNumber()
+		_ = decoder.Decode(&body)
+	}
+
+	a.mu.Lock()
+	a.calls = append(a.calls, call)
+	a.bodies[call] = body
+	answer, ok := a.routes[call]
+	a.mu.Unlock()
+
+	if handler, isHandler := answer.(func(map[string]interface{}) interface{}); isHandler {
+		answer = handler(body)
+	}
+
+	switch {
+	case !ok:
+		writeFakeOVHError(w, http.StatusNotFound, "no route for "+call)
+	case answer == nil:
+		w.WriteHeader(http.StatusOK)
+	default:
+		if code, isError := answer.(fakeOVHError); isError {
+			writeFakeOVHError(w, int(code), http.StatusText(int(code)))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(answer)
+	}
+}
+
+func writeFakeOVHError(w http.ResponseWriter, code int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(map[string]string{"message": message})
+}
+
+func TestAPIIdentifier(t *testing.T) {
+	tests := []struct {
+		name  string
+		api   string
+		prior types.String
+		want  types.String
+	}{
+		{"same object", "ANALYTICS.PUBLIC.ORDERS", types.StringValue("analytics.public.orders"), types.StringValue("analytics.public.orders")},
+		{"quoted", `ANALYTICS.PUBLIC."Orders"`, types.StringValue(`analytics.public."Orders"`), types.StringValue(`analytics.public."Orders"`)},
+		{"different case when quoted", `ANALYTICS.PUBLIC."Orders"`, types.StringValue("analytics.public.orders"), types.StringValue(`ANALYTICS.PUBLIC."Orders"`)},
+		{"other object", "ANALYTICS.PUBLIC.ORDERS", types.StringValue("analytics.public.customers"), types.StringValue("ANALYTICS.PUBLIC.ORDERS")},
+		{"import", "ANALYTICS.PUBLIC.ORDERS", types.StringNull(), types.StringValue("ANALYTICS.PUBLIC.ORDERS")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := apiIdentifier(map[string]interface{}{"name": tt.api}, "name", tt.prior)
+			if !got.Equal(tt.want) {
+				t.Errorf("apiIdentifier(%q, %s) = %s, want %s", tt.api, tt.prior, got, tt.want)
+			}
+		})
+	}
+}
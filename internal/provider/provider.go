@@ -2,18 +2,26 @@ package provider
 
 import (
 	"context"
-
+	"fmt"
 	"os"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-var _ provider.Provider = &SnowflakeOVHProvider{}
+var (
+	_ provider.Provider                       = &SnowflakeOVHProvider{}
+	_ provider.ProviderWithEphemeralResources = &SnowflakeOVHProvider{}
+	_ provider.ProviderWithFunctions          = &SnowflakeOVHProvider{}
+)
 
 type SnowflakeOVHProvider struct {
 	version string
@@ -30,6 +38,7 @@ type SnowflakeOVHProviderModel struct {
 	SnowflakePrivateKey  types.String `tfsdk:"snowflake_private_key"`
 	SnowflakeRole        types.String `tfsdk:"snowflake_role"`
 	SnowflakeWarehouse   types.String `tfsdk:"snowflake_warehouse"`
+	SnowflakeEdition     types.String `tfsdk:"snowflake_edition"`
 }
 
 func (p *SnowflakeOVHProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -84,6 +93,13 @@ func (p *SnowflakeOVHProvider) Schema(ctx context.Context, req provider.SchemaRe
 				MarkdownDescription: "Snowflake warehouse",
 				Optional:            true,
 			},
+			"snowflake_edition": schema.StringAttribute{
+				MarkdownDescription: "Snowflake edition of the account (`STANDARD`, `ENTERPRISE`, `BUSINESS_CRITICAL` or `VPS`). When set, features the edition lacks, such as multi-cluster warehouses on `STANDARD`, are rejected at plan time.",
+				Optional:            true,
+				Validators: []validator.String{
+					oneOfValidator{values: accountEditions},
+				},
+			},
 		},
 	}
 }
@@ -151,11 +167,17 @@ func (p *SnowflakeOVHProvider) Configure(ctx context.Context, req provider.Confi
 		snowflakeWarehouse = config.SnowflakeWarehouse.ValueString()
 	}
 
+	snowflakeEdition := os.Getenv("SNOWFLAKE_EDITION")
+	if !config.SnowflakeEdition.IsNull() {
+		snowflakeEdition = config.SnowflakeEdition.ValueString()
+	}
+
 	tflog.Debug(ctx, "Snowflake configuration loaded", map[string]interface{}{
 		"has_password":    snowflakePassword != "",
 		"has_private_key": snowflakePrivateKey != "",
 		"role":            snowflakeRole,
 		"warehouse":       snowflakeWarehouse,
+		"edition":         snowflakeEdition,
 	})
 
 	if ovhApplicationKey == "" {
@@ -182,6 +204,14 @@ func (p *SnowflakeOVHProvider) Configure(ctx context.Context, req provider.Confi
 		return
 	}
 
+	if snowflakeEdition != "" && accountEditionRank(snowflakeEdition) < 0 {
+		resp.Diagnostics.AddError(
+			"Unknown Snowflake edition",
+			fmt.Sprintf("snowflake_edition must be one of %s, got %q", strings.Join(accountEditions, ", "), snowflakeEdition),
+		)
+		return
+	}
+
 	ctx = tflog.SetField(ctx, "ovh_endpoint", ovhEndpoint)
 	ctx = tflog.SetField(ctx, "ovh_application_key", ovhApplicationKey)
 	ctx = tflog.SetField(ctx, "snowflake_account", snowflakeAccount)
@@ -202,6 +232,8 @@ func (p *SnowflakeOVHProvider) Configure(ctx context.Context, req provider.Confi
 		return
 	}
 
+	client.SnowflakeEdition = snowflakeEdition
+
 	if err := client.ValidateConfiguration(ctx); err != nil {
 		resp.Diagnostics.AddError(
 			"Failed to validate provider configuration",
@@ -212,6 +244,7 @@ func (p *SnowflakeOVHProvider) Configure(ctx context.Context, req provider.Confi
 
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.EphemeralResourceData = client
 
 	tflog.Info(ctx, "Configured Snowflake OVH client", map[string]any{"success": true})
 }
@@ -226,12 +259,47 @@ func (p *SnowflakeOVHProvider) Resources(ctx context.Context) []func() resource.
 		NewSnowflakeRoleResource,
 		NewSnowflakeGrantResource,
 		NewSnowflakeResourceMonitorResource,
+		NewSnowflakeFileFormatResource,
+		NewSnowflakeViewResource,
+		NewSnowflakeMaterializedViewResource,
+		NewSnowflakeDynamicTableResource,
+		NewSnowflakeMaskingPolicyResource,
+		NewSnowflakeMaskingPolicyAttachmentResource,
+		NewSnowflakeRowAccessPolicyResource,
+		NewSnowflakeRowAccessPolicyAttachmentResource,
+		NewSnowflakeTagResource,
+		NewSnowflakeTagAssociationResource,
+		NewSnowflakeShareResource,
+		NewSnowflakeReplicationGroupResource,
+		NewSnowflakeFailoverGroupResource,
+		NewSnowflakeAccountParameterResource,
+		NewSnowflakeObjectParameterResource,
+		NewSnowflakePasswordPolicyResource,
+		NewSnowflakeSessionPolicyResource,
+		NewSnowflakeAuthenticationPolicyResource,
+		NewSnowflakeSecurityPolicyAttachmentResource,
 	}
 }
 
 func (p *SnowflakeOVHProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewSnowflakeAccountsDataSource,
+		NewSnowflakeInboundSharesDataSource,
+	}
+}
+
+func (p *SnowflakeOVHProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewSnowflakeTemporaryCredentialEphemeralResource,
+	}
+}
+
+func (p *SnowflakeOVHProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewSnowflakeFullyQualifiedNameFunction,
+		NewSnowflakeParseIdentifierFunction,
+		NewSnowflakeQuoteIdentifierFunction,
+		NewSnowflakeNormalizeWarehouseSizeFunction,
 	}
 }
 
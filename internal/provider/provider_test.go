@@ -1,10 +1,14 @@
 package provider
 
 import (
+	"context"
 	"os"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -57,6 +61,163 @@ func TestProvider_Resources(t *testing.T) {
 	t.Logf("Provider registered %d resource types", len(resources))
 }
 
+func TestProvider_ResourceSchemas(t *testing.T) {
+	ctx := context.Background()
+	provider := New("test")()
+
+	for _, newResource := range provider.Resources(ctx) {
+		r := newResource()
+
+		metadata := &fwresource.MetadataResponse{}
+		r.Metadata(ctx, fwresource.MetadataRequest{ProviderTypeName: "snowflake-ovh"}, metadata)
+
+		t.Run(metadata.TypeName, func(t *testing.T) {
+			schemaResp := &fwresource.SchemaResponse{}
+			r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+			if schemaResp.Diagnostics.HasError() {
+				t.Fatalf("Schema returned diagnostics: %v", schemaResp.Diagnostics)
+			}
+
+			if diags := schemaResp.Schema.ValidateImplementation(ctx); diags.HasError() {
+				t.Errorf("Schema is invalid: %v", diags)
+			}
+		})
+	}
+}
+
+func TestProvider_ResourcesImportable(t *testing.T) {
+	ctx := context.Background()
+	p := New("test")().(*SnowflakeOVHProvider)
+
+	for _, newResource := range p.Resources(ctx) {
+		r := newResource()
+
+		metadata := &fwresource.MetadataResponse{}
+		r.Metadata(ctx, fwresource.MetadataRequest{ProviderTypeName: "snowflake-ovh"}, metadata)
+
+		if _, ok := r.(fwresource.ResourceWithImportState); !ok {
+			t.Errorf("%s does not implement ImportState", metadata.TypeName)
+		}
+	}
+}
+
+func TestProvider_ResourceStateUpgraders(t *testing.T) {
+	ctx := context.Background()
+	p := New("test")().(*SnowflakeOVHProvider)
+
+	for _, newResource := range p.Resources(ctx) {
+		r := newResource()
+
+		metadata := &fwresource.MetadataResponse{}
+		r.Metadata(ctx, fwresource.MetadataRequest{ProviderTypeName: "snowflake-ovh"}, metadata)
+
+		schemaResp := &fwresource.SchemaResponse{}
+		r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+		if schemaResp.Schema.Version == 0 {
+			continue
+		}
+
+		// State of every earlier version must be upgraded to the current one.
+		withUpgrades, ok := r.(fwresource.ResourceWithUpgradeState)
+		if !ok {
+			t.Errorf("%s has schema version %d but does not implement UpgradeState", metadata.TypeName, schemaResp.Schema.Version)
+			continue
+		}
+		upgraders := withUpgrades.UpgradeState(ctx)
+		for version := int64(0); version < schemaResp.Schema.Version; version++ {
+			if upgrader, ok := upgraders[version]; !ok || upgrader.PriorSchema == nil {
+				t.Errorf("%s has no state upgrader with a prior schema for version %d", metadata.TypeName, version)
+			}
+		}
+	}
+}
+
+func TestProvider_ResourceIdentitySchemas(t *testing.T) {
+	ctx := context.Background()
+	p := New("test")().(*SnowflakeOVHProvider)
+
+	for _, newResource := range p.Resources(ctx) {
+		r := newResource()
+
+		metadata := &fwresource.MetadataResponse{}
+		r.Metadata(ctx, fwresource.MetadataRequest{ProviderTypeName: "snowflake-ovh"}, metadata)
+
+		t.Run(metadata.TypeName, func(t *testing.T) {
+			withIdentity, ok := r.(fwresource.ResourceWithIdentity)
+			if !ok {
+				t.Fatal("resource does not implement IdentitySchema")
+			}
+
+			identityResp := &fwresource.IdentitySchemaResponse{}
+			withIdentity.IdentitySchema(ctx, fwresource.IdentitySchemaRequest{}, identityResp)
+			if diags := identityResp.IdentitySchema.ValidateImplementation(ctx); diags.HasError() {
+				t.Fatalf("identity schema validation failed: %v", diags)
+			}
+
+			schemaResp := &fwresource.SchemaResponse{}
+			r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+			// Identity attributes other than the account are copied from
+			// state attributes of the same name.
+			for name := range identityResp.IdentitySchema.Attributes {
+				if name == identityAccountAttribute {
+					continue
+				}
+				if _, ok := schemaResp.Schema.Attributes[name]; !ok {
+					t.Errorf("identity attribute %s has no matching schema attribute", name)
+				}
+			}
+		})
+	}
+}
+
+func TestProvider_EphemeralResourceSchemas(t *testing.T) {
+	ctx := context.Background()
+	p := New("test")().(*SnowflakeOVHProvider)
+
+	for _, newEphemeralResource := range p.EphemeralResources(ctx) {
+		e := newEphemeralResource()
+
+		metadata := &ephemeral.MetadataResponse{}
+		e.Metadata(ctx, ephemeral.MetadataRequest{ProviderTypeName: "snowflake-ovh"}, metadata)
+
+		t.Run(metadata.TypeName, func(t *testing.T) {
+			schemaResp := &ephemeral.SchemaResponse{}
+			e.Schema(ctx, ephemeral.SchemaRequest{}, schemaResp)
+			if schemaResp.Diagnostics.HasError() {
+				t.Fatalf("Schema returned diagnostics: %v", schemaResp.Diagnostics)
+			}
+
+			if diags := schemaResp.Schema.ValidateImplementation(ctx); diags.HasError() {
+				t.Errorf("Schema is invalid: %v", diags)
+			}
+		})
+	}
+}
+
+func TestProvider_Functions(t *testing.T) {
+	ctx := context.Background()
+	p := New("test")().(*SnowflakeOVHProvider)
+
+	for _, newFunction := range p.Functions(ctx) {
+		f := newFunction()
+
+		metadata := &function.MetadataResponse{}
+		f.Metadata(ctx, function.MetadataRequest{}, metadata)
+
+		t.Run(metadata.Name, func(t *testing.T) {
+			definitionResp := &function.DefinitionResponse{}
+			f.Definition(ctx, function.DefinitionRequest{}, definitionResp)
+
+			validateResp := &function.DefinitionValidateResponse{}
+			definitionResp.Definition.ValidateImplementation(ctx, function.DefinitionValidateRequest{FuncName: metadata.Name}, validateResp)
+			if validateResp.Diagnostics.HasError() {
+				t.Errorf("Definition is invalid: %v", validateResp.Diagnostics)
+			}
+		})
+	}
+}
+
 func TestProvider_DataSources(t *testing.T) {
 	provider := New("test")()
 
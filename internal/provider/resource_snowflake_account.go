@@ -4,30 +4,36 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/swcstudio/terraform-provider-ovh.snowflake/internal/sdk/identifiers"
 )
 
 func resourceSnowflakeAccount() *schema.Resource {
 	return &schema.Resource{
 		Description: "Manages a Snowflake account on OVH infrastructure with enterprise features",
 
+		CustomizeDiff: resourceSnowflakeAccountCustomizeDiff,
+
 		CreateContext: resourceSnowflakeAccountCreate,
 		ReadContext:   resourceSnowflakeAccountRead,
 		UpdateContext: resourceSnowflakeAccountUpdate,
 		DeleteContext: resourceSnowflakeAccountDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateLookup("/cloud/project/snowflake/account", "name"),
 		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "Name of the Snowflake account",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the Snowflake account",
+				ValidateFunc: identifiers.ValidateNameFunc,
 			},
 			"region": {
 				Type:        schema.TypeString,
@@ -39,12 +45,10 @@ func resourceSnowflakeAccount() *schema.Resource {
 				}, false),
 			},
 			"edition": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Snowflake edition",
-				ValidateFunc: validation.StringInSlice([]string{
-					"STANDARD", "ENTERPRISE", "BUSINESS_CRITICAL", "VPS",
-				}, false),
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Snowflake edition. Can be upgraded in place (STANDARD < ENTERPRISE < BUSINESS_CRITICAL < VPS); downgrades are rejected at plan time.",
+				ValidateFunc: validation.StringInSlice(accountEditions, false),
 			},
 			"admin_name": {
 				Type:        schema.TypeString,
@@ -52,10 +56,35 @@ func resourceSnowflakeAccount() *schema.Resource {
 				Description: "Administrator username",
 			},
 			"admin_password": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Sensitive:   true,
-				Description: "Administrator password",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ExactlyOneOf: accountAdminCredentialKeys,
+				Deprecated:   "Use admin_password_wo and admin_password_wo_version to keep the password out of plan and state.",
+				Description:  "Administrator password. Changing it rotates the password in place; it is never read back from the API but is stored in state, so prefer admin_password_wo. After an import, the configured password is taken as the current one and not rotated.",
+			},
+			"admin_password_wo": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				WriteOnly:    true,
+				ExactlyOneOf: accountAdminCredentialKeys,
+				RequiredWith: []string{"admin_password_wo_version"},
+				Description:  "Write-only administrator password, never stored in plan or state. Change admin_password_wo_version to rotate it. Requires Terraform 1.11 or later.",
+			},
+			"admin_password_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"admin_password_wo"},
+				Description:  "Version of admin_password_wo. The password is only sent on create and when this value changes, except after an import, where the configured password is taken as the current one.",
+			},
+			"admin_rsa_public_key": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ExactlyOneOf:     accountAdminCredentialKeys,
+				Description:      "RSA public key, PEM encoded or as the bare base64 body, for key pair authentication of the administrator, so that no password is needed. Changing it rotates the key in place.",
+				ValidateFunc:     validateRSAPublicKey,
+				DiffSuppressFunc: suppressRSAPublicKeyDiff,
 			},
 			"admin_email": {
 				Type:        schema.TypeString,
@@ -155,7 +184,6 @@ func resourceSnowflakeAccountCreate(ctx context.Context, d *schema.ResourceData,
 		"region":               d.Get("region").(string),
 		"edition":              d.Get("edition").(string),
 		"adminName":            d.Get("admin_name").(string),
-		"adminPassword":        d.Get("admin_password").(string),
 		"adminEmail":           d.Get("admin_email").(string),
 		"comment":              d.Get("comment").(string),
 		"autoSuspend":          d.Get("auto_suspend").(int),
@@ -166,6 +194,13 @@ func resourceSnowflakeAccountCreate(ctx context.Context, d *schema.ResourceData,
 		"privateConnectivity":  d.Get("private_connectivity").(bool),
 		"tags":                 d.Get("tags"),
 	}
+	credentials, diags := accountAdminCredentials(d)
+	if diags.HasError() {
+		return diags
+	}
+	for key, value := range credentials {
+		accountConfig[key] = value
+	}
 
 	var result map[string]interface{}
 	err := config.OVHClient.Post("/cloud/project/snowflake/account", accountConfig, &result)
@@ -187,34 +222,37 @@ func resourceSnowflakeAccountRead(ctx context.Context, d *schema.ResourceData, m
 
 	var account map[string]interface{}
 	err := config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/account/%s", accountId), &account)
-	if err != nil {
+	if isNotFoundError(err) {
 		d.SetId("")
-		return diag.FromErr(fmt.Errorf("failed to read Snowflake account: %w", err))
+		return nil
 	}
-
-	d.Set("name", account["name"])
-	d.Set("region", account["region"])
-	d.Set("edition", account["edition"])
-	d.Set("admin_name", account["adminName"])
-	d.Set("admin_email", account["adminEmail"])
-	d.Set("comment", account["comment"])
-	d.Set("auto_suspend", account["autoSuspend"])
-	d.Set("auto_resume", account["autoResume"])
-	d.Set("web3_analytics", account["web3Analytics"])
-	d.Set("blockchain_connectors", account["blockchainConnectors"])
-	d.Set("cost_optimization", account["costOptimization"])
-	d.Set("private_connectivity", account["privateConnectivity"])
-	d.Set("account_locator", account["accountLocator"])
-	d.Set("account_url", account["accountUrl"])
-	d.Set("organization_name", account["organizationName"])
-	d.Set("status", account["status"])
-	d.Set("created_on", account["createdOn"])
-
-	if tags, ok := account["tags"].(map[string]interface{}); ok {
-		d.Set("tags", tags)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read Snowflake account: %w", err))
 	}
 
-	return nil
+	return setAPIFields(d, resourceSnowflakeAccount().Schema, account, map[string]string{
+		"name":        "name",
+		"region":      "region",
+		"edition":     "edition",
+		"admin_name":  "adminName",
+		"admin_email": "adminEmail",
+		// The admin password is never returned; the public key is, so that a
+		// key replaced outside Terraform shows up as drift.
+		"admin_rsa_public_key":  "adminRsaPublicKey",
+		"comment":               "comment",
+		"auto_suspend":          "autoSuspend",
+		"auto_resume":           "autoResume",
+		"web3_analytics":        "web3Analytics",
+		"blockchain_connectors": "blockchainConnectors",
+		"cost_optimization":     "costOptimization",
+		"private_connectivity":  "privateConnectivity",
+		"account_locator":       "accountLocator",
+		"account_url":           "accountUrl",
+		"organization_name":     "organizationName",
+		"status":                "status",
+		"created_on":            "createdOn",
+		"tags":                  "tags",
+	})
 }
 
 func resourceSnowflakeAccountUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -223,6 +261,34 @@ func resourceSnowflakeAccountUpdate(ctx context.Context, d *schema.ResourceData,
 
 	accountId := d.Id()
 
+	if d.HasChange("edition") {
+		oldEdition, newEdition := d.GetChange("edition")
+		tflog.Info(ctx, "Upgrading Snowflake account edition", map[string]interface{}{
+			"id":   accountId,
+			"from": oldEdition,
+			"to":   newEdition,
+		})
+
+		err := config.OVHClient.Post(fmt.Sprintf("/cloud/project/snowflake/account/%s/edition", accountId), map[string]interface{}{
+			"edition": newEdition.(string),
+		}, nil)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed to upgrade Snowflake account edition: %w", err))
+		}
+	}
+
+	if d.HasChanges("admin_password", "admin_password_wo_version", "admin_rsa_public_key") && !accountAdminPasswordUnknown(d) {
+		credentials, diags := accountAdminCredentials(d)
+		if diags.HasError() {
+			return diags
+		}
+
+		err := config.OVHClient.Put(fmt.Sprintf("/cloud/project/snowflake/account/%s/admin", accountId), credentials, nil)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed to rotate Snowflake account admin credentials: %w", err))
+		}
+	}
+
 	if d.HasChanges("comment", "auto_suspend", "auto_resume", "tags") {
 		updateConfig := map[string]interface{}{}
 
@@ -262,3 +328,68 @@ func resourceSnowflakeAccountDelete(ctx context.Context, d *schema.ResourceData,
 	d.SetId("")
 	return nil
 }
+
+// accountEditions lists the Snowflake editions from lowest to highest. An
+// account can move up this list in place but never down.
+var accountEditions = []string{"STANDARD", "ENTERPRISE", "BUSINESS_CRITICAL", "VPS"}
+
+func accountEditionRank(edition string) int {
+	for i, e := range accountEditions {
+		if e == edition {
+			return i
+		}
+	}
+	return -1
+}
+
+// checkAccountEditionChange returns an error when moving from one edition to
+// the other would be a downgrade.
+func checkAccountEditionChange(from, to string) error {
+	if from == "" || from == to {
+		return nil
+	}
+	if accountEditionRank(to) < accountEditionRank(from) {
+		return fmt.Errorf("edition cannot be downgraded from %s to %s; create a new account with the lower edition and migrate to it instead", from, to)
+	}
+	return nil
+}
+
+// resourceSnowflakeAccountCustomizeDiff rejects edition downgrades at plan
+// time, since they would otherwise fail halfway through an apply.
+func resourceSnowflakeAccountCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("edition") || !d.NewValueKnown("edition") {
+		return nil
+	}
+
+	from, to := d.GetChange("edition")
+	return checkAccountEditionChange(from.(string), to.(string))
+}
+
+// accountAdminCredentialKeys are the mutually exclusive ways to authenticate
+// the account administrator.
+var accountAdminCredentialKeys = []string{"admin_password", "admin_password_wo", "admin_rsa_public_key"}
+
+// accountAdminPasswordUnknown reports whether the prior state records no
+// administrator credential while a password is configured, as after an
+// import, since the API never returns the password. The configured password
+// is then taken as the current one rather than rotated.
+func accountAdminPasswordUnknown(d *schema.ResourceData) bool {
+	oldPassword, _ := d.GetChange("admin_password")
+	oldVersion, _ := d.GetChange("admin_password_wo_version")
+	oldKey, newKey := d.GetChange("admin_rsa_public_key")
+	return oldPassword.(string) == "" && oldVersion.(int) == 0 && oldKey.(string) == "" && newKey.(string) == ""
+}
+
+// accountAdminCredentials returns the API payload for whichever administrator
+// credential is configured.
+func accountAdminCredentials(d *schema.ResourceData) (map[string]interface{}, diag.Diagnostics) {
+	if key := d.Get("admin_rsa_public_key").(string); key != "" {
+		return map[string]interface{}{"adminRsaPublicKey": normalizeRSAPublicKey(key)}, nil
+	}
+	if password := d.Get("admin_password").(string); password != "" {
+		return map[string]interface{}{"adminPassword": password}, nil
+	}
+
+	password, diags := writeOnlyString(d, "admin_password_wo")
+	return map[string]interface{}{"adminPassword": password}, diags
+}
@@ -0,0 +1,165 @@
+package provider
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestCheckAccountEditionChange(t *testing.T) {
+	tests := []struct {
+		from, to string
+		wantErr  bool
+	}{
+		{"", "STANDARD", false},
+		{"STANDARD", "STANDARD", false},
+		{"STANDARD", "ENTERPRISE", false},
+		{"STANDARD", "VPS", false},
+		{"ENTERPRISE", "BUSINESS_CRITICAL", false},
+		{"ENTERPRISE", "STANDARD", true},
+		{"VPS", "BUSINESS_CRITICAL", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.from+"->"+tt.to, func(t *testing.T) {
+			err := checkAccountEditionChange(tt.from, tt.to)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("checkAccountEditionChange(%q, %q) error = %v, wantErr %v", tt.from, tt.to, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestResourceSnowflakeAccountSchema(t *testing.T) {
+	if err := resourceSnowflakeAccount().InternalValidate(nil, true); err != nil {
+		t.Fatalf("schema validation failed: %s", err)
+	}
+}
+
+func TestResourceSnowflakeAccountAdminRSAPublicKey(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generating key: %s", err)
+	}
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatalf("marshalling key: %s", err)
+	}
+	bare := base64.StdEncoding.EncodeToString(der)
+	armored := string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+
+	raw := func(publicKey string) map[string]interface{} {
+		return map[string]interface{}{
+			"name":                 "ANALYTICS",
+			"region":               "GRA",
+			"edition":              "STANDARD",
+			"admin_name":           "ADMIN",
+			"admin_email":          "admin@example.com",
+			"admin_rsa_public_key": publicKey,
+		}
+	}
+
+	r := resourceSnowflakeAccount()
+	if diags := r.Validate(terraform.NewResourceConfigRaw(raw("not a key"))); !diags.HasError() {
+		t.Error("expected an invalid admin_rsa_public_key to be rejected")
+	}
+	if diags := r.Validate(terraform.NewResourceConfigRaw(raw(armored))); diags.HasError() {
+		t.Errorf("expected a PEM encoded key to be accepted, got %v", diags)
+	}
+
+	// The API reports the bare body, which must not differ from a configured
+	// PEM encoded key.
+	d := schema.TestResourceDataRaw(t, r.Schema, raw(bare))
+	d.SetId("1")
+	diff, err := r.Diff(context.Background(), d.State(), terraform.NewResourceConfigRaw(raw(armored)), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if diff != nil {
+		if attr, ok := diff.Attributes["admin_rsa_public_key"]; ok {
+			t.Errorf("expected no admin_rsa_public_key diff, got %#v", attr)
+		}
+	}
+
+	credentials, diags := accountAdminCredentials(schema.TestResourceDataRaw(t, r.Schema, raw(armored)))
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if credentials["adminRsaPublicKey"] != bare {
+		t.Errorf("expected the key to be sent without PEM armor, got %v", credentials["adminRsaPublicKey"])
+	}
+}
+
+func TestResourceSnowflakeAccountUpdate_ImportedPassword(t *testing.T) {
+	const rotate = "PUT /cloud/project/snowflake/account/1/admin"
+	account := map[string]interface{}{
+		"id": "1", "name": "ANALYTICS", "region": "GRA", "edition": "STANDARD",
+		"adminName": "ADMIN", "adminEmail": "admin@example.com",
+	}
+	config := map[string]interface{}{
+		"name":           "ANALYTICS",
+		"region":         "GRA",
+		"edition":        "STANDARD",
+		"admin_name":     "ADMIN",
+		"admin_email":    "admin@example.com",
+		"admin_password": "S3cret!",
+	}
+
+	tests := []struct {
+		name       string
+		password   string
+		wantRotate bool
+	}{
+		// Import leaves the password out of state; the configured one is
+		// taken as current.
+		{name: "imported", password: "", wantRotate: false},
+		{name: "changed", password: "0ldS3cret!", wantRotate: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			api, meta := newFakeOVHAPI(t, map[string]interface{}{
+				"GET /cloud/project/snowflake/account/1": account,
+				"PUT /cloud/project/snowflake/account/1": nil,
+				rotate:                                   nil,
+			})
+			r := resourceSnowflakeAccount()
+
+			d := r.Data(nil)
+			d.SetId("1")
+			if diags := resourceSnowflakeAccountRead(ctx, d, meta); diags.HasError() {
+				t.Fatalf("read failed: %v", diags)
+			}
+			if tt.password != "" {
+				d.Set("admin_password", tt.password)
+			}
+			state := d.State()
+
+			diff, err := r.Diff(ctx, state, terraform.NewResourceConfigRaw(config), meta)
+			if err != nil {
+				t.Fatalf("diff failed: %s", err)
+			}
+			if _, diags := r.Apply(ctx, state, diff, meta); diags.HasError() {
+				t.Fatalf("update failed: %v", diags)
+			}
+
+			rotated := false
+			for _, call := range api.recorded() {
+				if call == rotate {
+					rotated = true
+				}
+			}
+			if rotated != tt.wantRotate {
+				t.Errorf("expected rotation %t, calls %q", tt.wantRotate, api.recorded())
+			}
+		})
+	}
+}
@@ -6,6 +6,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/swcstudio/terraform-provider-ovh.snowflake/internal/sdk/identifiers"
 )
 
 func resourceSnowflakeDatabase() *schema.Resource {
@@ -18,15 +20,16 @@ func resourceSnowflakeDatabase() *schema.Resource {
 		DeleteContext: resourceSnowflakeDatabaseDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateLookup("/cloud/project/snowflake/database", "name"),
 		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "Name of the database",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the database",
+				ValidateFunc: identifiers.ValidateNameFunc,
 			},
 			"comment": {
 				Type:        schema.TypeString,
@@ -113,22 +116,22 @@ func resourceSnowflakeDatabaseRead(ctx context.Context, d *schema.ResourceData,
 
 	var database map[string]interface{}
 	err := config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/database/%s", databaseId), &database)
-	if err != nil {
+	if isNotFoundError(err) {
 		d.SetId("")
-		return diag.FromErr(fmt.Errorf("failed to read Snowflake database: %w", err))
+		return nil
 	}
-
-	d.Set("name", database["name"])
-	d.Set("comment", database["comment"])
-	d.Set("data_retention_time_in_days", database["dataRetentionTimeInDays"])
-	d.Set("owner", database["owner"])
-	d.Set("created_on", database["createdOn"])
-
-	if tags, ok := database["tags"].(map[string]interface{}); ok {
-		d.Set("tags", tags)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read Snowflake database: %w", err))
 	}
 
-	return nil
+	return setAPIFields(d, resourceSnowflakeDatabase().Schema, database, map[string]string{
+		"name":                        "name",
+		"comment":                     "comment",
+		"data_retention_time_in_days": "dataRetentionTimeInDays",
+		"owner":                       "owner",
+		"created_on":                  "createdOn",
+		"tags":                        "tags",
+	})
 }
 
 func resourceSnowflakeDatabaseUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -6,6 +6,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/swcstudio/terraform-provider-ovh.snowflake/internal/sdk/identifiers"
 )
 
 func resourceSnowflakeExternalTable() *schema.Resource {
@@ -18,27 +20,30 @@ func resourceSnowflakeExternalTable() *schema.Resource {
 		DeleteContext: resourceSnowflakeExternalTableDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateLookup("/cloud/project/snowflake/external-table", "database", "schema", "name"),
 		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "External table name",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "External table name",
+				ValidateFunc: identifiers.ValidateNameFunc,
 			},
 			"database": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "Database name",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Database name",
+				ValidateFunc: identifiers.ValidateNameFunc,
 			},
 			"schema": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "Schema name",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Schema name",
+				ValidateFunc: identifiers.ValidateNameFunc,
 			},
 			"columns": {
 				Type:        schema.TypeList,
@@ -156,26 +161,29 @@ func resourceSnowflakeExternalTableRead(ctx context.Context, d *schema.ResourceD
 
 	var table map[string]interface{}
 	err := config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/external-table/%s", tableId), &table)
-	if err != nil {
+	if isNotFoundError(err) {
 		d.SetId("")
+		return nil
+	}
+	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to read Snowflake external table: %w", err))
 	}
 
-	d.Set("name", table["name"])
-	d.Set("database", table["database"])
-	d.Set("schema", table["schema"])
-	d.Set("columns", table["columns"])
-	d.Set("location", table["location"])
-	d.Set("file_format", table["fileFormat"])
-	d.Set("pattern", table["pattern"])
-	d.Set("partition_by", table["partitionBy"])
-	d.Set("auto_refresh", table["autoRefresh"])
-	d.Set("refresh_on_create", table["refreshOnCreate"])
-	d.Set("comment", table["comment"])
-	d.Set("owner", table["owner"])
-	d.Set("created_on", table["createdOn"])
-
-	return nil
+	return setAPIFields(d, resourceSnowflakeExternalTable().Schema, table, map[string]string{
+		"name":              "name",
+		"database":          "database",
+		"schema":            "schema",
+		"columns":           "columns",
+		"location":          "location",
+		"file_format":       "fileFormat",
+		"pattern":           "pattern",
+		"partition_by":      "partitionBy",
+		"auto_refresh":      "autoRefresh",
+		"refresh_on_create": "refreshOnCreate",
+		"comment":           "comment",
+		"owner":             "owner",
+		"created_on":        "createdOn",
+	})
 }
 
 func resourceSnowflakeExternalTableUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -19,7 +19,7 @@ func resourceSnowflakeGrant() *schema.Resource {
 		DeleteContext: resourceSnowflakeGrantDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateLookup("/cloud/project/snowflake/grant", "privilege", "on", "objectName", "toRole"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -121,22 +121,25 @@ func resourceSnowflakeGrantRead(ctx context.Context, d *schema.ResourceData, met
 
 	var grant map[string]interface{}
 	err := config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/grant/%s", grantId), &grant)
-	if err != nil {
+	if isNotFoundError(err) {
 		d.SetId("")
+		return nil
+	}
+	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to read Snowflake grant: %w", err))
 	}
 
-	d.Set("privilege", grant["privilege"])
-	d.Set("on", grant["on"])
-	d.Set("object_name", grant["objectName"])
-	d.Set("to_role", grant["toRole"])
-	d.Set("to_user", grant["toUser"])
-	d.Set("with_grant_option", grant["withGrantOption"])
-	d.Set("granted_on", grant["grantedOn"])
-	d.Set("granted_to", grant["grantedTo"])
-	d.Set("granted_by", grant["grantedBy"])
-
-	return nil
+	return setAPIFields(d, resourceSnowflakeGrant().Schema, grant, map[string]string{
+		"privilege":         "privilege",
+		"on":                "on",
+		"object_name":       "objectName",
+		"to_role":           "toRole",
+		"to_user":           "toUser",
+		"with_grant_option": "withGrantOption",
+		"granted_on":        "grantedOn",
+		"granted_to":        "grantedTo",
+		"granted_by":        "grantedBy",
+	})
 }
 
 func resourceSnowflakeGrantUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
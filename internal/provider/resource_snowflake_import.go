@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// importStateLookup returns an importer that accepts either the OVH ID of an
+// object or a human-readable ID made of the values of the given API keys
+// separated by importIDSeparator, such as ANALYTICS|PUBLIC|EVENTS for the keys
+// database, schema and name. Human-readable IDs are resolved to the OVH ID
+// through the list endpoint.
+func importStateLookup(endpoint string, keys ...string) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		config := meta.(*Config)
+		id := d.Id()
+
+		// IDs that name an existing object are OVH IDs and are used as they are.
+		if !strings.Contains(id, importIDSeparator) {
+			var object map[string]interface{}
+			err := config.OVHClient.Get(fmt.Sprintf("%s/%s", endpoint, url.PathEscape(id)), &object)
+			if err == nil {
+				return []*schema.ResourceData{d}, nil
+			}
+			if !isNotFoundError(err) {
+				return nil, fmt.Errorf("failed to read %s: %w", id, err)
+			}
+		}
+
+		parts, err := splitImportID(id, strings.Join(keys, importIDSeparator), len(keys), len(keys))
+		if err != nil {
+			return nil, err
+		}
+
+		filters := map[string]string{}
+		for i, key := range keys {
+			filters[key] = parts[i]
+		}
+
+		ovhId, err := lookupImportID(config, endpoint, filters)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find %s to import: %w", id, err)
+		}
+
+		d.SetId(ovhId)
+		return []*schema.ResourceData{d}, nil
+	}
+}
@@ -6,6 +6,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/swcstudio/terraform-provider-ovh.snowflake/internal/sdk/identifiers"
 )
 
 func resourceSnowflakeNetworkPolicy() *schema.Resource {
@@ -18,15 +20,16 @@ func resourceSnowflakeNetworkPolicy() *schema.Resource {
 		DeleteContext: resourceSnowflakeNetworkPolicyDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateLookup("/cloud/project/snowflake/network-policy", "name"),
 		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "Network policy name",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Network policy name",
+				ValidateFunc: identifiers.ValidateNameFunc,
 			},
 			"allowed_ip_list": {
 				Type:        schema.TypeList,
@@ -89,18 +92,21 @@ func resourceSnowflakeNetworkPolicyRead(ctx context.Context, d *schema.ResourceD
 
 	var policy map[string]interface{}
 	err := config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/network-policy/%s", policyId), &policy)
-	if err != nil {
+	if isNotFoundError(err) {
 		d.SetId("")
+		return nil
+	}
+	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to read Snowflake network policy: %w", err))
 	}
 
-	d.Set("name", policy["name"])
-	d.Set("allowed_ip_list", policy["allowedIpList"])
-	d.Set("blocked_ip_list", policy["blockedIpList"])
-	d.Set("comment", policy["comment"])
-	d.Set("created_on", policy["createdOn"])
-
-	return nil
+	return setAPIFields(d, resourceSnowflakeNetworkPolicy().Schema, policy, map[string]string{
+		"name":            "name",
+		"allowed_ip_list": "allowedIpList",
+		"blocked_ip_list": "blockedIpList",
+		"comment":         "comment",
+		"created_on":      "createdOn",
+	})
 }
 
 func resourceSnowflakeNetworkPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
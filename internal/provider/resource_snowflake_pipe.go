@@ -6,39 +6,46 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/swcstudio/terraform-provider-ovh.snowflake/internal/sdk/identifiers"
 )
 
 func resourceSnowflakePipe() *schema.Resource {
 	return &schema.Resource{
 		Description: "Manages a Snowflake pipe",
 
+		CustomizeDiff: resourceSnowflakePipeCustomizeDiff,
+
 		CreateContext: resourceSnowflakePipeCreate,
 		ReadContext:   resourceSnowflakePipeRead,
 		UpdateContext: resourceSnowflakePipeUpdate,
 		DeleteContext: resourceSnowflakePipeDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateLookup("/cloud/project/snowflake/pipe", "database", "schema", "name"),
 		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "Pipe name",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Pipe name",
+				ValidateFunc: identifiers.ValidateNameFunc,
 			},
 			"database": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "Database name",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Database name",
+				ValidateFunc: identifiers.ValidateNameFunc,
 			},
 			"schema": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "Schema name",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Schema name",
+				ValidateFunc: identifiers.ValidateNameFunc,
 			},
 			"copy_statement": {
 				Type:        schema.TypeString,
@@ -48,18 +55,39 @@ func resourceSnowflakePipe() *schema.Resource {
 			"auto_ingest": {
 				Type:        schema.TypeBool,
 				Optional:    true,
+				ForceNew:    true,
 				Default:     false,
-				Description: "Enable auto ingest",
+				Description: "Enable auto ingest from OVH Object Storage event notifications",
+			},
+			"integration": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Notification integration that delivers OVH Object Storage events to the pipe; required when auto_ingest is enabled",
 			},
 			"aws_sns_topic": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Deprecated:    "Use integration instead. Until aws_sns_topic is removed, it is used as the notification integration of auto-ingest pipes.",
+				ConflictsWith: []string{"integration"},
+				Description:   "Notification source of an auto-ingest pipe; deprecated alias of integration",
+			},
+			"error_integration": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "AWS SNS topic ARN",
+				Description: "Notification integration used for load error notifications",
 			},
-			"integration": {
+			"paused": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Pause the pipe",
+			},
+			"refresh_trigger": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "Integration name",
+				Description: "Arbitrary value; changing it queues files staged in the last 7 days for loading",
 			},
 			"comment": {
 				Type:        schema.TypeString,
@@ -81,6 +109,21 @@ func resourceSnowflakePipe() *schema.Resource {
 				Computed:    true,
 				Description: "Creation timestamp",
 			},
+			"execution_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Pipe execution state",
+			},
+			"pending_file_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of files queued for loading",
+			},
+			"last_ingested_timestamp": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Timestamp of the last file loaded",
+			},
 		},
 	}
 }
@@ -90,14 +133,15 @@ func resourceSnowflakePipeCreate(ctx context.Context, d *schema.ResourceData, me
 	_ = diag.Diagnostics{}
 
 	pipeConfig := map[string]interface{}{
-		"name":          d.Get("name").(string),
-		"database":      d.Get("database").(string),
-		"schema":        d.Get("schema").(string),
-		"copyStatement": d.Get("copy_statement").(string),
-		"autoIngest":    d.Get("auto_ingest").(bool),
-		"awsSnsTopic":   d.Get("aws_sns_topic").(string),
-		"integration":   d.Get("integration").(string),
-		"comment":       d.Get("comment").(string),
+		"name":             d.Get("name").(string),
+		"database":         d.Get("database").(string),
+		"schema":           d.Get("schema").(string),
+		"copyStatement":    d.Get("copy_statement").(string),
+		"autoIngest":       d.Get("auto_ingest").(bool),
+		"integration":      pipeIntegration(d),
+		"errorIntegration": d.Get("error_integration").(string),
+		"executionPaused":  d.Get("paused").(bool),
+		"comment":          d.Get("comment").(string),
 	}
 
 	var result map[string]interface{}
@@ -120,24 +164,50 @@ func resourceSnowflakePipeRead(ctx context.Context, d *schema.ResourceData, meta
 
 	var pipe map[string]interface{}
 	err := config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/pipe/%s", pipeId), &pipe)
-	if err != nil {
+	if isNotFoundError(err) {
 		d.SetId("")
+		return nil
+	}
+	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to read Snowflake pipe: %w", err))
 	}
 
-	d.Set("name", pipe["name"])
-	d.Set("database", pipe["database"])
-	d.Set("schema", pipe["schema"])
-	d.Set("copy_statement", pipe["copyStatement"])
-	d.Set("auto_ingest", pipe["autoIngest"])
-	d.Set("aws_sns_topic", pipe["awsSnsTopic"])
-	d.Set("integration", pipe["integration"])
-	d.Set("comment", pipe["comment"])
-	d.Set("notification_channel", pipe["notificationChannel"])
-	d.Set("owner", pipe["owner"])
-	d.Set("created_on", pipe["createdOn"])
+	attributes := resourceSnowflakePipe().Schema
+	diags := setAPIFields(d, attributes, pipe, map[string]string{
+		"name":                 "name",
+		"database":             "database",
+		"schema":               "schema",
+		"copy_statement":       "copyStatement",
+		"auto_ingest":          "autoIngest",
+		"integration":          "integration",
+		"error_integration":    "errorIntegration",
+		"paused":               "executionPaused",
+		"comment":              "comment",
+		"notification_channel": "notificationChannel",
+		"owner":                "owner",
+		"created_on":           "createdOn",
+	})
+	if diags.HasError() {
+		return diags
+	}
+	// An integration configured through aws_sns_topic stays recorded there.
+	if topic := d.Get("aws_sns_topic").(string); topic != "" && d.Get("integration").(string) == topic {
+		if err := d.Set("integration", ""); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	var status map[string]interface{}
+	err = config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/pipe/%s/status", pipeId), &status)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read Snowflake pipe status: %w", err))
+	}
 
-	return nil
+	return setAPIFields(d, attributes, status, map[string]string{
+		"execution_state":         "executionState",
+		"pending_file_count":      "pendingFileCount",
+		"last_ingested_timestamp": "lastIngestedTimestamp",
+	})
 }
 
 func resourceSnowflakePipeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -146,20 +216,17 @@ func resourceSnowflakePipeUpdate(ctx context.Context, d *schema.ResourceData, me
 
 	pipeId := d.Id()
 
-	if d.HasChanges("copy_statement", "auto_ingest", "aws_sns_topic", "integration", "comment") {
+	if d.HasChanges("copy_statement", "error_integration", "paused", "comment") {
 		updateConfig := map[string]interface{}{}
 
 		if d.HasChange("copy_statement") {
 			updateConfig["copyStatement"] = d.Get("copy_statement").(string)
 		}
-		if d.HasChange("auto_ingest") {
-			updateConfig["autoIngest"] = d.Get("auto_ingest").(bool)
-		}
-		if d.HasChange("aws_sns_topic") {
-			updateConfig["awsSnsTopic"] = d.Get("aws_sns_topic").(string)
+		if d.HasChange("error_integration") {
+			updateConfig["errorIntegration"] = d.Get("error_integration").(string)
 		}
-		if d.HasChange("integration") {
-			updateConfig["integration"] = d.Get("integration").(string)
+		if d.HasChange("paused") {
+			updateConfig["executionPaused"] = d.Get("paused").(bool)
 		}
 		if d.HasChange("comment") {
 			updateConfig["comment"] = d.Get("comment").(string)
@@ -171,6 +238,13 @@ func resourceSnowflakePipeUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
+	if d.HasChange("refresh_trigger") {
+		err := config.OVHClient.Post(fmt.Sprintf("/cloud/project/snowflake/pipe/%s/refresh", pipeId), nil, nil)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed to refresh Snowflake pipe: %w", err))
+		}
+	}
+
 	return resourceSnowflakePipeRead(ctx, d, meta)
 }
 
@@ -188,3 +262,33 @@ func resourceSnowflakePipeDelete(ctx context.Context, d *schema.ResourceData, me
 	d.SetId("")
 	return nil
 }
+
+// pipeIntegration returns the notification integration of an auto-ingest
+// pipe, falling back to the deprecated aws_sns_topic. Pipes that do not
+// ingest automatically have none.
+func pipeIntegration(d *schema.ResourceData) string {
+	if !d.Get("auto_ingest").(bool) {
+		return ""
+	}
+	if integration := d.Get("integration").(string); integration != "" {
+		return integration
+	}
+	return d.Get("aws_sns_topic").(string)
+}
+
+// resourceSnowflakePipeCustomizeDiff checks that auto_ingest and its
+// notification integration are configured together. aws_sns_topic stands in
+// for integration, and has no effect without auto_ingest.
+func resourceSnowflakePipeCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	autoIngest := d.Get("auto_ingest").(bool)
+	integration := d.Get("integration").(string)
+	known := d.NewValueKnown("integration") && d.NewValueKnown("aws_sns_topic")
+
+	if autoIngest && integration == "" && d.Get("aws_sns_topic").(string) == "" && known {
+		return fmt.Errorf("integration is required when auto_ingest is enabled")
+	}
+	if !autoIngest && integration != "" {
+		return fmt.Errorf("integration can only be set when auto_ingest is enabled")
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestResourceSnowflakePipeCustomizeDiff(t *testing.T) {
+	testCases := map[string]struct {
+		config  map[string]interface{}
+		wantErr bool
+	}{
+		"auto_ingest_with_integration": {
+			config: map[string]interface{}{"auto_ingest": true, "integration": "OBJECT_STORAGE_EVENTS"},
+		},
+		"auto_ingest_without_integration": {
+			config:  map[string]interface{}{"auto_ingest": true},
+			wantErr: true,
+		},
+		"integration_without_auto_ingest": {
+			config:  map[string]interface{}{"integration": "OBJECT_STORAGE_EVENTS"},
+			wantErr: true,
+		},
+		"auto_ingest_with_aws_sns_topic": {
+			config: map[string]interface{}{"auto_ingest": true, "aws_sns_topic": "arn:aws:sns:eu-west-1:123456789012:events"},
+		},
+		"aws_sns_topic_without_auto_ingest": {
+			config: map[string]interface{}{"aws_sns_topic": "arn:aws:sns:eu-west-1:123456789012:events"},
+		},
+		"plain": {
+			config: map[string]interface{}{},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tc.config["name"] = "EVENTS_PIPE"
+			tc.config["database"] = "ANALYTICS"
+			tc.config["schema"] = "PUBLIC"
+			tc.config["copy_statement"] = "COPY INTO EVENTS FROM @EVENTS_STAGE"
+
+			_, err := resourceSnowflakePipe().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(tc.config), nil)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("expected error %v, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestPipeIntegration(t *testing.T) {
+	testCases := map[string]struct {
+		raw      map[string]interface{}
+		expected string
+	}{
+		"integration":    {raw: map[string]interface{}{"auto_ingest": true, "integration": "EVENTS"}, expected: "EVENTS"},
+		"aws_sns_topic":  {raw: map[string]interface{}{"auto_ingest": true, "aws_sns_topic": "arn:aws:sns:topic"}, expected: "arn:aws:sns:topic"},
+		"no_auto_ingest": {raw: map[string]interface{}{"aws_sns_topic": "arn:aws:sns:topic"}, expected: ""},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceSnowflakePipe().Schema, tc.raw)
+			if got := pipeIntegration(d); got != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestResourceSnowflakePipeRead_Status(t *testing.T) {
+	_, config := newFakeOVHAPI(t, map[string]interface{}{
+		"GET /cloud/project/snowflake/pipe/1": map[string]interface{}{
+			"name": "EVENTS_PIPE", "database": "ANALYTICS", "schema": "PUBLIC",
+			"copyStatement": "COPY INTO EVENTS FROM @EVENTS_STAGE", "autoIngest": true,
+			"integration": "arn:aws:sns:topic", "executionPaused": false,
+		},
+		"GET /cloud/project/snowflake/pipe/1/status": map[string]interface{}{
+			"executionState": "RUNNING", "pendingFileCount": json.Number("12"), "lastIngestedTimestamp": "2026-10-19T08:00:00Z",
+		},
+	})
+
+	d := resourceSnowflakePipe().Data(&terraform.InstanceState{ID: "1", Attributes: map[string]string{
+		"auto_ingest":   "true",
+		"aws_sns_topic": "arn:aws:sns:topic",
+	}})
+	if diags := resourceSnowflakePipeRead(context.Background(), d, config); diags.HasError() {
+		t.Fatalf("read failed: %v", diags)
+	}
+
+	checks := map[string]interface{}{
+		"execution_state":         "RUNNING",
+		"pending_file_count":      12,
+		"last_ingested_timestamp": "2026-10-19T08:00:00Z",
+		// The integration configured through aws_sns_topic is not reported
+		// a second time.
+		"integration":   "",
+		"aws_sns_topic": "arn:aws:sns:topic",
+	}
+	for key, want := range checks {
+		if got := d.Get(key); got != want {
+			t.Errorf("%s = %#v, want %#v", key, got, want)
+		}
+	}
+}
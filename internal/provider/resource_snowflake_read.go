@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// setAPIFields copies an OVH API object into d. fields maps attribute names to
+// the API keys holding their values. Values are converted to the type of the
+// attribute, and attributes the API does not report are reset to their schema
+// default, or cleared when they have none, so that the state of an imported
+// object matches a configuration that only sets non-default values.
+func setAPIFields(d *schema.ResourceData, attributes map[string]*schema.Schema, object map[string]interface{}, fields map[string]string) diag.Diagnostics {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var diags diag.Diagnostics
+	for _, name := range names {
+		attribute, ok := attributes[name]
+		if !ok {
+			diags = append(diags, diag.Errorf("unknown attribute %s", name)...)
+			continue
+		}
+
+		if err := d.Set(name, apiValue(attribute, object[fields[name]])); err != nil {
+			diags = append(diags, diag.FromErr(fmt.Errorf("failed to set %s: %w", name, err))...)
+		}
+	}
+	return diags
+}
+
+// apiValue converts a value decoded from an OVH API response to the Go type
+// the SDK expects for attribute, falling back to the attribute's default when
+// the value is missing or of the wrong type.
+func apiValue(attribute *schema.Schema, value interface{}) interface{} {
+	switch attribute.Type {
+	case schema.TypeString:
+		if v, ok := value.(string); ok {
+			return v
+		}
+	case schema.TypeBool:
+		if v, ok := value.(bool); ok {
+			return v
+		}
+	case schema.TypeInt:
+		switch v := value.(type) {
+		case json.Number:
+			if i, err := v.Int64(); err == nil {
+				return int(i)
+			}
+		case float64:
+			return int(v)
+		case string:
+			// Some counters, such as the pending file count of a pipe, are
+			// reported as strings.
+			if i, err := strconv.Atoi(v); err == nil {
+				return i
+			}
+		}
+	case schema.TypeFloat:
+		switch v := value.(type) {
+		case json.Number:
+			if f, err := v.Float64(); err == nil {
+				return f
+			}
+		case float64:
+			return v
+		}
+	case schema.TypeList, schema.TypeSet:
+		if items, ok := value.([]interface{}); ok {
+			return apiList(attribute, items)
+		}
+	case schema.TypeMap:
+		if items, ok := value.(map[string]interface{}); ok {
+			return apiMap(attribute, items)
+		}
+	}
+
+	return attribute.Default
+}
+
+// apiList converts the elements of a list or set. Elements of nested blocks
+// are objects whose keys are the attribute names of the block.
+func apiList(attribute *schema.Schema, items []interface{}) []interface{} {
+	result := make([]interface{}, 0, len(items))
+	for _, item := range items {
+		switch elem := attribute.Elem.(type) {
+		case *schema.Schema:
+			if v := apiValue(elem, item); v != nil {
+				result = append(result, v)
+			}
+		case *schema.Resource:
+			object, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			block := map[string]interface{}{}
+			for name, nested := range elem.Schema {
+				block[name] = apiValue(nested, object[name])
+			}
+			result = append(result, block)
+		}
+	}
+	return result
+}
+
+// apiMap converts the values of a map attribute, which default to strings.
+func apiMap(attribute *schema.Schema, items map[string]interface{}) map[string]interface{} {
+	elem, ok := attribute.Elem.(*schema.Schema)
+	if !ok {
+		elem = &schema.Schema{Type: schema.TypeString}
+	}
+
+	result := make(map[string]interface{}, len(items))
+	for key, item := range items {
+		if v := apiValue(elem, item); v != nil {
+			result[key] = v
+		}
+	}
+	return result
+}
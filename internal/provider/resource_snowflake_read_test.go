@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestSetAPIFields(t *testing.T) {
+	attributes := resourceSnowflakeWarehouse().Schema
+	d := schema.TestResourceDataRaw(t, attributes, map[string]interface{}{
+		"name":         "OLD",
+		"comment":      "stale comment",
+		"auto_suspend": 300,
+	})
+
+	warehouse := map[string]interface{}{
+		"name":            "ANALYTICS_WH",
+		"size":            "MEDIUM",
+		"maxClusterCount": json.Number("3"),
+		"autoResume":      false,
+		"tags":            map[string]interface{}{"team": "data"},
+	}
+
+	diags := setAPIFields(d, attributes, warehouse, map[string]string{
+		"name":              "name",
+		"size":              "size",
+		"max_cluster_count": "maxClusterCount",
+		"auto_suspend":      "autoSuspend",
+		"auto_resume":       "autoResume",
+		"comment":           "comment",
+		"tags":              "tags",
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	checks := map[string]interface{}{
+		"name":              "ANALYTICS_WH",
+		"size":              "MEDIUM",
+		"max_cluster_count": 3,
+		// Attributes the API leaves out fall back to their default...
+		"auto_suspend": 60,
+		"auto_resume":  false,
+		// ...or are cleared when they have none.
+		"comment":   "",
+		"tags.team": "data",
+	}
+	for key, want := range checks {
+		if got := d.Get(key); got != want {
+			t.Errorf("%s = %#v, want %#v", key, got, want)
+		}
+	}
+}
+
+func TestSetAPIFields_NestedBlocks(t *testing.T) {
+	attributes := resourceSnowflakeTable().Schema
+	d := schema.TestResourceDataRaw(t, attributes, map[string]interface{}{})
+
+	table := map[string]interface{}{
+		"columns": []interface{}{
+			map[string]interface{}{"name": "ID", "type": "NUMBER"},
+		},
+		"clusterBy":               []interface{}{"ID"},
+		"dataRetentionTimeInDays": float64(7),
+	}
+
+	diags := setAPIFields(d, attributes, table, map[string]string{
+		"columns":                     "columns",
+		"cluster_by":                  "clusterBy",
+		"data_retention_time_in_days": "dataRetentionTimeInDays",
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got := d.Get("columns.0.name"); got != "ID" {
+		t.Errorf("columns.0.name = %#v, want ID", got)
+	}
+	if got := d.Get("columns.0.nullable"); got != true {
+		t.Errorf("columns.0.nullable = %#v, want the default true", got)
+	}
+	if got := d.Get("cluster_by.0"); got != "ID" {
+		t.Errorf("cluster_by.0 = %#v, want ID", got)
+	}
+	if got := d.Get("data_retention_time_in_days"); got != 7 {
+		t.Errorf("data_retention_time_in_days = %#v, want 7", got)
+	}
+}
+
+func TestSetAPIFields_UnknownAttribute(t *testing.T) {
+	attributes := resourceSnowflakeRole().Schema
+	d := schema.TestResourceDataRaw(t, attributes, map[string]interface{}{})
+
+	diags := setAPIFields(d, attributes, map[string]interface{}{}, map[string]string{
+		"not_an_attribute": "notAnAttribute",
+	})
+	if !diags.HasError() {
+		t.Fatal("expected an error for an attribute missing from the schema")
+	}
+}
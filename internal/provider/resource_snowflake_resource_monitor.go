@@ -7,6 +7,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/swcstudio/terraform-provider-ovh.snowflake/internal/sdk/identifiers"
 )
 
 func resourceSnowflakeResourceMonitor() *schema.Resource {
@@ -19,15 +21,16 @@ func resourceSnowflakeResourceMonitor() *schema.Resource {
 		DeleteContext: resourceSnowflakeResourceMonitorDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateLookup("/cloud/project/snowflake/resource-monitor", "name"),
 		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "Resource monitor name",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Resource monitor name",
+				ValidateFunc: identifiers.ValidateNameFunc,
 			},
 			"credit_quota": {
 				Type:         schema.TypeInt,
@@ -145,25 +148,28 @@ func resourceSnowflakeResourceMonitorRead(ctx context.Context, d *schema.Resourc
 
 	var monitor map[string]interface{}
 	err := config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/resource-monitor/%s", monitorId), &monitor)
-	if err != nil {
+	if isNotFoundError(err) {
 		d.SetId("")
+		return nil
+	}
+	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to read Snowflake resource monitor: %w", err))
 	}
 
-	d.Set("name", monitor["name"])
-	d.Set("credit_quota", monitor["creditQuota"])
-	d.Set("frequency", monitor["frequency"])
-	d.Set("start_timestamp", monitor["startTimestamp"])
-	d.Set("end_timestamp", monitor["endTimestamp"])
-	d.Set("notify_triggers", monitor["notifyTriggers"])
-	d.Set("suspend_triggers", monitor["suspendTriggers"])
-	d.Set("suspend_immediate_triggers", monitor["suspendImmediateTriggers"])
-	d.Set("notify_users", monitor["notifyUsers"])
-	d.Set("comment", monitor["comment"])
-	d.Set("created_on", monitor["createdOn"])
-	d.Set("owner", monitor["owner"])
-
-	return nil
+	return setAPIFields(d, resourceSnowflakeResourceMonitor().Schema, monitor, map[string]string{
+		"name":                       "name",
+		"credit_quota":               "creditQuota",
+		"frequency":                  "frequency",
+		"start_timestamp":            "startTimestamp",
+		"end_timestamp":              "endTimestamp",
+		"notify_triggers":            "notifyTriggers",
+		"suspend_triggers":           "suspendTriggers",
+		"suspend_immediate_triggers": "suspendImmediateTriggers",
+		"notify_users":               "notifyUsers",
+		"comment":                    "comment",
+		"created_on":                 "createdOn",
+		"owner":                      "owner",
+	})
 }
 
 func resourceSnowflakeResourceMonitorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
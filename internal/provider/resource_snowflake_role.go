@@ -6,6 +6,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/swcstudio/terraform-provider-ovh.snowflake/internal/sdk/identifiers"
 )
 
 func resourceSnowflakeRole() *schema.Resource {
@@ -18,15 +20,16 @@ func resourceSnowflakeRole() *schema.Resource {
 		DeleteContext: resourceSnowflakeRoleDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateLookup("/cloud/project/snowflake/role", "name"),
 		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "Role name",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Role name",
+				ValidateFunc: identifiers.ValidateNameFunc,
 			},
 			"comment": {
 				Type:        schema.TypeString,
@@ -85,21 +88,21 @@ func resourceSnowflakeRoleRead(ctx context.Context, d *schema.ResourceData, meta
 
 	var role map[string]interface{}
 	err := config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/role/%s", roleId), &role)
-	if err != nil {
+	if isNotFoundError(err) {
 		d.SetId("")
-		return diag.FromErr(fmt.Errorf("failed to read Snowflake role: %w", err))
+		return nil
 	}
-
-	d.Set("name", role["name"])
-	d.Set("comment", role["comment"])
-	d.Set("owner", role["owner"])
-	d.Set("created_on", role["createdOn"])
-
-	if tags, ok := role["tags"].(map[string]interface{}); ok {
-		d.Set("tags", tags)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read Snowflake role: %w", err))
 	}
 
-	return nil
+	return setAPIFields(d, resourceSnowflakeRole().Schema, role, map[string]string{
+		"name":       "name",
+		"comment":    "comment",
+		"owner":      "owner",
+		"created_on": "createdOn",
+		"tags":       "tags",
+	})
 }
 
 func resourceSnowflakeRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -6,6 +6,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/swcstudio/terraform-provider-ovh.snowflake/internal/sdk/identifiers"
 )
 
 func resourceSnowflakeSchema() *schema.Resource {
@@ -18,21 +20,23 @@ func resourceSnowflakeSchema() *schema.Resource {
 		DeleteContext: resourceSnowflakeSchemaDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateLookup("/cloud/project/snowflake/schema", "database", "name"),
 		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "Name of the schema",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the schema",
+				ValidateFunc: identifiers.ValidateNameFunc,
 			},
 			"database": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "Database name",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Database name",
+				ValidateFunc: identifiers.ValidateNameFunc,
 			},
 			"comment": {
 				Type:        schema.TypeString,
@@ -114,25 +118,25 @@ func resourceSnowflakeSchemaRead(ctx context.Context, d *schema.ResourceData, me
 
 	var schema map[string]interface{}
 	err := config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/schema/%s", schemaId), &schema)
-	if err != nil {
+	if isNotFoundError(err) {
 		d.SetId("")
-		return diag.FromErr(fmt.Errorf("failed to read Snowflake schema: %w", err))
+		return nil
 	}
-
-	d.Set("name", schema["name"])
-	d.Set("database", schema["database"])
-	d.Set("comment", schema["comment"])
-	d.Set("is_transient", schema["isTransient"])
-	d.Set("is_managed", schema["isManaged"])
-	d.Set("data_retention_time_in_days", schema["dataRetentionTimeInDays"])
-	d.Set("owner", schema["owner"])
-	d.Set("created_on", schema["createdOn"])
-
-	if tags, ok := schema["tags"].(map[string]interface{}); ok {
-		d.Set("tags", tags)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read Snowflake schema: %w", err))
 	}
 
-	return nil
+	return setAPIFields(d, resourceSnowflakeSchema().Schema, schema, map[string]string{
+		"name":                        "name",
+		"database":                    "database",
+		"comment":                     "comment",
+		"is_transient":                "isTransient",
+		"is_managed":                  "isManaged",
+		"data_retention_time_in_days": "dataRetentionTimeInDays",
+		"owner":                       "owner",
+		"created_on":                  "createdOn",
+		"tags":                        "tags",
+	})
 }
 
 func resourceSnowflakeSchemaUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
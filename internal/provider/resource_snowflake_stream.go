@@ -6,11 +6,15 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/swcstudio/terraform-provider-ovh.snowflake/internal/sdk/identifiers"
 )
 
 func resourceSnowflakeStream() *schema.Resource {
 	return &schema.Resource{
-		Description: "Manages a Snowflake stream",
+		Description: "Manages a Snowflake stream. A stream that has gone stale is replaced on the next apply.",
+
+		CustomizeDiff: resourceSnowflakeStreamCustomizeDiff,
 
 		CreateContext: resourceSnowflakeStreamCreate,
 		ReadContext:   resourceSnowflakeStreamRead,
@@ -18,39 +22,65 @@ func resourceSnowflakeStream() *schema.Resource {
 		DeleteContext: resourceSnowflakeStreamDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateLookup("/cloud/project/snowflake/stream", "database", "schema", "name"),
 		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "Stream name",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Stream name",
+				ValidateFunc: identifiers.ValidateNameFunc,
 			},
 			"database": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "Database name",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Database name",
+				ValidateFunc: identifiers.ValidateNameFunc,
 			},
 			"schema": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "Schema name",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Schema name",
+				ValidateFunc: identifiers.ValidateNameFunc,
 			},
 			"on_table": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				ForceNew:    true,
-				Description: "Table to create stream on",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: streamSourceKeys,
+				Description:  "Table to create stream on",
 			},
 			"on_view": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				ForceNew:    true,
-				Description: "View to create stream on",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: streamSourceKeys,
+				Description:  "View to create stream on",
+			},
+			"on_external_table": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: streamSourceKeys,
+				Description:  "External table to create stream on; requires insert_only",
+			},
+			"on_stage": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: streamSourceKeys,
+				Description:  "Stage whose directory table the stream tracks",
+			},
+			"on_dynamic_table": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: streamSourceKeys,
+				Description:  "Dynamic table to create stream on",
 			},
 			"append_only": {
 				Type:        schema.TypeBool,
@@ -59,6 +89,31 @@ func resourceSnowflakeStream() *schema.Resource {
 				ForceNew:    true,
 				Description: "Create append-only stream",
 			},
+			"insert_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+				Description: "Create insert-only stream (external tables only)",
+			},
+			"at": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"before"},
+				Description:   "Start the stream at a point in time, inclusive",
+				Elem:          streamOffsetResource(),
+			},
+			"before": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"at"},
+				Description:   "Start the stream just before a point in time",
+				Elem:          streamOffsetResource(),
+			},
 			"show_initial_rows": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -96,6 +151,11 @@ func resourceSnowflakeStream() *schema.Resource {
 				Computed:    true,
 				Description: "Stream is stale",
 			},
+			"stale_after": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Timestamp after which the stream becomes stale unless consumed",
+			},
 			"mode": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -115,11 +175,22 @@ func resourceSnowflakeStreamCreate(ctx context.Context, d *schema.ResourceData,
 		"schema":          d.Get("schema").(string),
 		"onTable":         d.Get("on_table").(string),
 		"onView":          d.Get("on_view").(string),
+		"onExternalTable": d.Get("on_external_table").(string),
+		"onStage":         d.Get("on_stage").(string),
+		"onDynamicTable":  d.Get("on_dynamic_table").(string),
 		"appendOnly":      d.Get("append_only").(bool),
+		"insertOnly":      d.Get("insert_only").(bool),
 		"showInitialRows": d.Get("show_initial_rows").(bool),
 		"comment":         d.Get("comment").(string),
 	}
 
+	if at := expandStreamOffset(d.Get("at").([]interface{})); at != nil {
+		streamConfig["at"] = at
+	}
+	if before := expandStreamOffset(d.Get("before").([]interface{})); before != nil {
+		streamConfig["before"] = before
+	}
+
 	var result map[string]interface{}
 	err := config.OVHClient.Post("/cloud/project/snowflake/stream", streamConfig, &result)
 	if err != nil {
@@ -140,27 +211,35 @@ func resourceSnowflakeStreamRead(ctx context.Context, d *schema.ResourceData, me
 
 	var stream map[string]interface{}
 	err := config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/stream/%s", streamId), &stream)
-	if err != nil {
+	if isNotFoundError(err) {
 		d.SetId("")
+		return nil
+	}
+	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to read Snowflake stream: %w", err))
 	}
 
-	d.Set("name", stream["name"])
-	d.Set("database", stream["database"])
-	d.Set("schema", stream["schema"])
-	d.Set("on_table", stream["onTable"])
-	d.Set("on_view", stream["onView"])
-	d.Set("append_only", stream["appendOnly"])
-	d.Set("show_initial_rows", stream["showInitialRows"])
-	d.Set("comment", stream["comment"])
-	d.Set("owner", stream["owner"])
-	d.Set("created_on", stream["createdOn"])
-	d.Set("table_name", stream["tableName"])
-	d.Set("type", stream["type"])
-	d.Set("stale", stream["stale"])
-	d.Set("mode", stream["mode"])
-
-	return nil
+	return setAPIFields(d, resourceSnowflakeStream().Schema, stream, map[string]string{
+		"name":              "name",
+		"database":          "database",
+		"schema":            "schema",
+		"on_table":          "onTable",
+		"on_view":           "onView",
+		"on_external_table": "onExternalTable",
+		"on_stage":          "onStage",
+		"on_dynamic_table":  "onDynamicTable",
+		"append_only":       "appendOnly",
+		"insert_only":       "insertOnly",
+		"show_initial_rows": "showInitialRows",
+		"comment":           "comment",
+		"owner":             "owner",
+		"created_on":        "createdOn",
+		"table_name":        "tableName",
+		"type":              "type",
+		"stale":             "stale",
+		"stale_after":       "staleAfter",
+		"mode":              "mode",
+	})
 }
 
 func resourceSnowflakeStreamUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -197,3 +276,90 @@ func resourceSnowflakeStreamDelete(ctx context.Context, d *schema.ResourceData,
 	d.SetId("")
 	return nil
 }
+
+var streamSourceKeys = []string{"on_table", "on_view", "on_external_table", "on_stage", "on_dynamic_table"}
+
+// streamOffsetResource describes an AT or BEFORE time-travel clause.
+func streamOffsetResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"timestamp": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Timestamp expression, such as TO_TIMESTAMP_TZ('2024-01-01 00:00:00')",
+			},
+			"offset": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Time difference in seconds from the current time, such as -3600",
+			},
+			"statement": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Query ID of a statement",
+			},
+			"stream": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Name of a stream whose current offset is used",
+			},
+		},
+		Description: "Exactly one of timestamp, offset, statement or stream must be set",
+	}
+}
+
+// expandStreamOffset converts an at or before block to its API form.
+func expandStreamOffset(blocks []interface{}) map[string]interface{} {
+	if len(blocks) == 0 || blocks[0] == nil {
+		return nil
+	}
+
+	block := blocks[0].(map[string]interface{})
+	offset := map[string]interface{}{}
+	for _, key := range []string{"timestamp", "offset", "statement", "stream"} {
+		if value, ok := block[key].(string); ok && value != "" {
+			offset[key] = value
+		}
+	}
+	return offset
+}
+
+// resourceSnowflakeStreamCustomizeDiff validates source-specific options and
+// replaces a stream that has gone stale, since a stale stream cannot be read
+// and Snowflake offers no way to recover it in place.
+func resourceSnowflakeStreamCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	onExternalTable := d.Get("on_external_table").(string) != ""
+	onStage := d.Get("on_stage").(string) != ""
+
+	if d.Get("insert_only").(bool) && !onExternalTable {
+		return fmt.Errorf("insert_only can only be set on streams on external tables")
+	}
+	if onExternalTable && !d.Get("insert_only").(bool) {
+		return fmt.Errorf("streams on external tables require insert_only")
+	}
+	if d.Get("append_only").(bool) && (onExternalTable || onStage) {
+		return fmt.Errorf("append_only is not supported on streams on external tables or stages")
+	}
+	if onStage && (len(d.Get("at").([]interface{})) > 0 || len(d.Get("before").([]interface{})) > 0) {
+		return fmt.Errorf("at and before are not supported on streams on stages")
+	}
+
+	for _, key := range []string{"at", "before"} {
+		if offset := expandStreamOffset(d.Get(key).([]interface{})); offset != nil && len(offset) != 1 {
+			return fmt.Errorf("%s requires exactly one of timestamp, offset, statement or stream", key)
+		}
+	}
+
+	if d.Id() != "" && d.Get("stale").(bool) {
+		if err := d.SetNew("stale", false); err != nil {
+			return err
+		}
+		return d.ForceNew("stale")
+	}
+
+	return nil
+}
@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestResourceSnowflakeStream_Schema(t *testing.T) {
+	if err := resourceSnowflakeStream().InternalValidate(nil, true); err != nil {
+		t.Fatalf("invalid stream schema: %s", err)
+	}
+}
+
+func TestExpandStreamOffset(t *testing.T) {
+	testCases := map[string]struct {
+		blocks   []interface{}
+		expected map[string]interface{}
+	}{
+		"absent": {
+			blocks:   nil,
+			expected: nil,
+		},
+		"offset": {
+			blocks: []interface{}{map[string]interface{}{
+				"timestamp": "", "offset": "-3600", "statement": "", "stream": "",
+			}},
+			expected: map[string]interface{}{"offset": "-3600"},
+		},
+		"empty_block": {
+			blocks: []interface{}{map[string]interface{}{
+				"timestamp": "", "offset": "", "statement": "", "stream": "",
+			}},
+			expected: map[string]interface{}{},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := expandStreamOffset(tc.blocks); !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestResourceSnowflakeStreamCustomizeDiff(t *testing.T) {
+	offset := []interface{}{map[string]interface{}{"offset": "-3600"}}
+	ambiguous := []interface{}{map[string]interface{}{"offset": "-3600", "timestamp": "2026-10-19 08:00:00"}}
+
+	testCases := map[string]struct {
+		config  map[string]interface{}
+		wantErr bool
+	}{
+		"table":                      {config: map[string]interface{}{"on_table": "EVENTS"}},
+		"table_append_only":          {config: map[string]interface{}{"on_table": "EVENTS", "append_only": true}},
+		"table_insert_only":          {config: map[string]interface{}{"on_table": "EVENTS", "insert_only": true}, wantErr: true},
+		"view_insert_only":           {config: map[string]interface{}{"on_view": "ACTIVE_EVENTS", "insert_only": true}, wantErr: true},
+		"external_table_insert_only": {config: map[string]interface{}{"on_external_table": "RAW_EVENTS", "insert_only": true}},
+		"external_table":             {config: map[string]interface{}{"on_external_table": "RAW_EVENTS"}, wantErr: true},
+		"external_table_append_only": {config: map[string]interface{}{"on_external_table": "RAW_EVENTS", "insert_only": true, "append_only": true}, wantErr: true},
+		"stage":                      {config: map[string]interface{}{"on_stage": "LANDING"}},
+		"stage_append_only":          {config: map[string]interface{}{"on_stage": "LANDING", "append_only": true}, wantErr: true},
+		"stage_at":                   {config: map[string]interface{}{"on_stage": "LANDING", "at": offset}, wantErr: true},
+		"table_at":                   {config: map[string]interface{}{"on_table": "EVENTS", "at": offset}},
+		"table_before_ambiguous":     {config: map[string]interface{}{"on_table": "EVENTS", "before": ambiguous}, wantErr: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tc.config["name"] = "EVENTS_STREAM"
+			tc.config["database"] = "ANALYTICS"
+			tc.config["schema"] = "PUBLIC"
+
+			_, err := resourceSnowflakeStream().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(tc.config), nil)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("expected error %v, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestResourceSnowflakeStreamCustomizeDiff_Stale(t *testing.T) {
+	config := map[string]interface{}{
+		"name":     "EVENTS_STREAM",
+		"database": "ANALYTICS",
+		"schema":   "PUBLIC",
+		"on_table": "EVENTS",
+	}
+	state := func(stale string) *terraform.InstanceState {
+		return &terraform.InstanceState{ID: "1", Attributes: map[string]string{
+			"id":                "1",
+			"name":              "EVENTS_STREAM",
+			"database":          "ANALYTICS",
+			"schema":            "PUBLIC",
+			"on_table":          "EVENTS",
+			"append_only":       "false",
+			"insert_only":       "false",
+			"show_initial_rows": "false",
+			"stale":             stale,
+		}}
+	}
+
+	// A stale stream can no longer be read, so it is replaced by a fresh one.
+	diff, err := resourceSnowflakeStream().Diff(context.Background(), state("true"), terraform.NewResourceConfigRaw(config), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if diff == nil || !diff.RequiresNew() {
+		t.Fatalf("expected a stale stream to be replaced, got %#v", diff)
+	}
+	// SetNew gives stale a change that ForceNew can act on; the replacement
+	// then leaves it to be computed by the new stream.
+	if attr := diff.Attributes["stale"]; attr == nil || attr.Old != "true" || !attr.RequiresNew {
+		t.Errorf("expected stale to force the replacement, got %#v", attr)
+	}
+
+	diff, err = resourceSnowflakeStream().Diff(context.Background(), state("false"), terraform.NewResourceConfigRaw(config), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if diff != nil && diff.RequiresNew() {
+		t.Errorf("expected a healthy stream to be kept, got %#v", diff)
+	}
+}
@@ -6,6 +6,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/swcstudio/terraform-provider-ovh.snowflake/internal/sdk/identifiers"
 )
 
 func resourceSnowflakeTable() *schema.Resource {
@@ -18,27 +20,30 @@ func resourceSnowflakeTable() *schema.Resource {
 		DeleteContext: resourceSnowflakeTableDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateLookup("/cloud/project/snowflake/table", "database", "schema", "name"),
 		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "Name of the table",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the table",
+				ValidateFunc: identifiers.ValidateNameFunc,
 			},
 			"database": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "Database name",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Database name",
+				ValidateFunc: identifiers.ValidateNameFunc,
 			},
 			"schema": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "Schema name",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Schema name",
+				ValidateFunc: identifiers.ValidateNameFunc,
 			},
 			"columns": {
 				Type:        schema.TypeList,
@@ -157,27 +162,27 @@ func resourceSnowflakeTableRead(ctx context.Context, d *schema.ResourceData, met
 
 	var table map[string]interface{}
 	err := config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/table/%s", tableId), &table)
-	if err != nil {
+	if isNotFoundError(err) {
 		d.SetId("")
-		return diag.FromErr(fmt.Errorf("failed to read Snowflake table: %w", err))
+		return nil
 	}
-
-	d.Set("name", table["name"])
-	d.Set("database", table["database"])
-	d.Set("schema", table["schema"])
-	d.Set("columns", table["columns"])
-	d.Set("comment", table["comment"])
-	d.Set("cluster_by", table["clusterBy"])
-	d.Set("data_retention_time_in_days", table["dataRetentionTimeInDays"])
-	d.Set("change_tracking", table["changeTracking"])
-	d.Set("owner", table["owner"])
-	d.Set("created_on", table["createdOn"])
-
-	if tags, ok := table["tags"].(map[string]interface{}); ok {
-		d.Set("tags", tags)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read Snowflake table: %w", err))
 	}
 
-	return nil
+	return setAPIFields(d, resourceSnowflakeTable().Schema, table, map[string]string{
+		"name":                        "name",
+		"database":                    "database",
+		"schema":                      "schema",
+		"columns":                     "columns",
+		"comment":                     "comment",
+		"cluster_by":                  "clusterBy",
+		"data_retention_time_in_days": "dataRetentionTimeInDays",
+		"change_tracking":             "changeTracking",
+		"owner":                       "owner",
+		"created_on":                  "createdOn",
+		"tags":                        "tags",
+	})
 }
 
 func resourceSnowflakeTableUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
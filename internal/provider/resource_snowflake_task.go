@@ -2,15 +2,23 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/url"
+	"slices"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/swcstudio/terraform-provider-ovh.snowflake/internal/sdk/identifiers"
 )
 
 func resourceSnowflakeTask() *schema.Resource {
 	return &schema.Resource{
-		Description: "Manages a Snowflake task",
+		Description: "Manages a Snowflake task. Root tasks of the task graph (DAG) the task belongs to are suspended while it is created, updated or deleted, and resumed afterwards.",
 
 		CreateContext: resourceSnowflakeTaskCreate,
 		ReadContext:   resourceSnowflakeTaskRead,
@@ -18,27 +26,30 @@ func resourceSnowflakeTask() *schema.Resource {
 		DeleteContext: resourceSnowflakeTaskDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateLookup("/cloud/project/snowflake/task", "database", "schema", "name"),
 		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "Task name",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Task name",
+				ValidateFunc: identifiers.ValidateNameFunc,
 			},
 			"database": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "Database name",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Database name",
+				ValidateFunc: identifiers.ValidateNameFunc,
 			},
 			"schema": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "Schema name",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Schema name",
+				ValidateFunc: identifiers.ValidateNameFunc,
 			},
 			"sql_statement": {
 				Type:        schema.TypeString,
@@ -46,14 +57,23 @@ func resourceSnowflakeTask() *schema.Resource {
 				Description: "SQL statement to execute",
 			},
 			"warehouse": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Warehouse to use",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"user_task_managed_initial_warehouse_size"},
+				Description:   "Warehouse to use",
+			},
+			"user_task_managed_initial_warehouse_size": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"warehouse"},
+				Description:   "Initial warehouse size for serverless tasks",
+				ValidateFunc:  validation.StringInSlice(warehouseSizes, false),
 			},
 			"schedule": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Task schedule",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"finalize"},
+				Description:   "Task schedule",
 			},
 			"session_parameters": {
 				Type:        schema.TypeMap,
@@ -74,13 +94,32 @@ func resourceSnowflakeTask() *schema.Resource {
 				Description: "Comment for the task",
 			},
 			"after": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				Description: "Tasks this task depends on",
+				Type:          schema.TypeList,
+				Optional:      true,
+				ConflictsWith: []string{"finalize"},
+				Description:   "Tasks this task depends on",
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
 			},
+			"finalize": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"after", "schedule", "config"},
+				Description:   "Root task this task runs as the finalizer of",
+			},
+			"config": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"after", "finalize"},
+				ValidateFunc:  validation.StringIsJSON,
+				Description:   "JSON configuration available to every task in the graph (root tasks only)",
+			},
+			"error_integration": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Notification integration used for error notifications",
+			},
 			"when": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -138,10 +177,23 @@ func resourceSnowflakeTaskCreate(ctx context.Context, d *schema.ResourceData, me
 		"after":             d.Get("after").([]interface{}),
 		"when":              d.Get("when").(string),
 		"enabled":           d.Get("enabled").(bool),
+		"finalize":          d.Get("finalize").(string),
+		"config":            d.Get("config").(string),
+		"errorIntegration":  d.Get("error_integration").(string),
+
+		"userTaskManagedInitialWarehouseSize": d.Get("user_task_managed_initial_warehouse_size").(string),
+	}
+
+	rootIds, err := taskGraphRoots(config, d.Get("database").(string), d.Get("schema").(string),
+		taskPredecessors(d.Get("after").([]interface{}), d.Get("finalize").(string)))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to create Snowflake task: %w", err))
 	}
 
 	var result map[string]interface{}
-	err := config.OVHClient.Post("/cloud/project/snowflake/task", taskConfig, &result)
+	err = withSuspendedTaskRoots(ctx, config, rootIds, nil, func() error {
+		return config.OVHClient.Post("/cloud/project/snowflake/task", taskConfig, &result)
+	})
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to create Snowflake task: %w", err))
 	}
@@ -160,30 +212,37 @@ func resourceSnowflakeTaskRead(ctx context.Context, d *schema.ResourceData, meta
 
 	var task map[string]interface{}
 	err := config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/task/%s", taskId), &task)
-	if err != nil {
+	if isNotFoundError(err) {
 		d.SetId("")
+		return nil
+	}
+	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to read Snowflake task: %w", err))
 	}
 
-	d.Set("name", task["name"])
-	d.Set("database", task["database"])
-	d.Set("schema", task["schema"])
-	d.Set("sql_statement", task["sqlStatement"])
-	d.Set("warehouse", task["warehouse"])
-	d.Set("schedule", task["schedule"])
-	d.Set("session_parameters", task["sessionParameters"])
-	d.Set("user_task_timeout_ms", task["userTaskTimeoutMs"])
-	d.Set("comment", task["comment"])
-	d.Set("after", task["after"])
-	d.Set("when", task["when"])
-	d.Set("enabled", task["enabled"])
-	d.Set("owner", task["owner"])
-	d.Set("created_on", task["createdOn"])
-	d.Set("state", task["state"])
-	d.Set("definition", task["definition"])
-	d.Set("condition", task["condition"])
-
-	return nil
+	return setAPIFields(d, resourceSnowflakeTask().Schema, task, map[string]string{
+		"name":                 "name",
+		"database":             "database",
+		"schema":               "schema",
+		"sql_statement":        "sqlStatement",
+		"warehouse":            "warehouse",
+		"schedule":             "schedule",
+		"session_parameters":   "sessionParameters",
+		"user_task_timeout_ms": "userTaskTimeoutMs",
+		"comment":              "comment",
+		"after":                "after",
+		"finalize":             "finalize",
+		"config":               "config",
+		"error_integration":    "errorIntegration",
+		"user_task_managed_initial_warehouse_size": "userTaskManagedInitialWarehouseSize",
+		"when":       "when",
+		"enabled":    "enabled",
+		"owner":      "owner",
+		"created_on": "createdOn",
+		"state":      "state",
+		"definition": "definition",
+		"condition":  "condition",
+	})
 }
 
 func resourceSnowflakeTaskUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -192,7 +251,8 @@ func resourceSnowflakeTaskUpdate(ctx context.Context, d *schema.ResourceData, me
 
 	taskId := d.Id()
 
-	if d.HasChanges("sql_statement", "warehouse", "schedule", "session_parameters", "user_task_timeout_ms", "comment", "after", "when", "enabled") {
+	if d.HasChanges("sql_statement", "warehouse", "schedule", "session_parameters", "user_task_timeout_ms", "comment", "after", "when", "enabled",
+		"finalize", "config", "error_integration", "user_task_managed_initial_warehouse_size") {
 		updateConfig := map[string]interface{}{}
 
 		if d.HasChange("sql_statement") {
@@ -222,8 +282,43 @@ func resourceSnowflakeTaskUpdate(ctx context.Context, d *schema.ResourceData, me
 		if d.HasChange("enabled") {
 			updateConfig["enabled"] = d.Get("enabled").(bool)
 		}
+		if d.HasChange("finalize") {
+			updateConfig["finalize"] = d.Get("finalize").(string)
+		}
+		if d.HasChange("config") {
+			updateConfig["config"] = d.Get("config").(string)
+		}
+		if d.HasChange("error_integration") {
+			updateConfig["errorIntegration"] = d.Get("error_integration").(string)
+		}
+		if d.HasChange("user_task_managed_initial_warehouse_size") {
+			updateConfig["userTaskManagedInitialWarehouseSize"] = d.Get("user_task_managed_initial_warehouse_size").(string)
+		}
 
-		err := config.OVHClient.Put(fmt.Sprintf("/cloud/project/snowflake/task/%s", taskId), updateConfig, nil)
+		// The graph must be suspended while any of its tasks changes, including
+		// the graphs the task is leaving or joining.
+		oldAfter, newAfter := d.GetChange("after")
+		oldFinalize, newFinalize := d.GetChange("finalize")
+		predecessors := append(
+			taskPredecessors(oldAfter.([]interface{}), oldFinalize.(string)),
+			taskPredecessors(newAfter.([]interface{}), newFinalize.(string))...,
+		)
+
+		rootIds, err := taskGraphRoots(config, d.Get("database").(string), d.Get("schema").(string), predecessors)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed to update Snowflake task: %w", err))
+		}
+		// A root task that is disabled stays suspended, and one that is
+		// enabled is resumed once its change is made.
+		started := map[string]bool{}
+		if len(newAfter.([]interface{})) == 0 && newFinalize.(string) == "" {
+			rootIds = append(rootIds, taskId)
+			started[taskId] = d.Get("enabled").(bool)
+		}
+
+		err = withSuspendedTaskRoots(ctx, config, rootIds, started, func() error {
+			return config.OVHClient.Put(fmt.Sprintf("/cloud/project/snowflake/task/%s", taskId), updateConfig, nil)
+		})
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("failed to update Snowflake task: %w", err))
 		}
@@ -237,12 +332,266 @@ func resourceSnowflakeTaskDelete(ctx context.Context, d *schema.ResourceData, me
 	_ = diag.Diagnostics{}
 
 	taskId := d.Id()
+	database := d.Get("database").(string)
+	schemaName := d.Get("schema").(string)
+	name := d.Get("name").(string)
+
+	predecessors := taskPredecessors(d.Get("after").([]interface{}), d.Get("finalize").(string))
+	rootIds, err := taskGraphRoots(config, database, schemaName, predecessors)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to delete Snowflake task: %w", err))
+	}
+
+	// A root task is suspended before it is deleted, so that its graph does
+	// not run while it is taken apart. Snowflake detaches its children and
+	// leaves them suspended.
+	started := map[string]bool{}
+	var children []string
+	if len(predecessors) == 0 {
+		rootIds = append(rootIds, taskId)
+		started[taskId] = false
+
+		children, err = taskChildren(config, database, schemaName, name)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed to delete Snowflake task: %w", err))
+		}
+	}
 
-	err := config.OVHClient.Delete(fmt.Sprintf("/cloud/project/snowflake/task/%s", taskId), nil)
+	err = withSuspendedTaskRoots(ctx, config, rootIds, started, func() error {
+		return config.OVHClient.Delete(fmt.Sprintf("/cloud/project/snowflake/task/%s", taskId), nil)
+	})
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to delete Snowflake task: %w", err))
 	}
 
 	d.SetId("")
-	return nil
+	if len(children) == 0 {
+		return nil
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  "Child tasks detached",
+		Detail: fmt.Sprintf("Snowflake detached %s from the deleted root task %s and left them suspended. "+
+			"Give them a new predecessor or a schedule and enable them again.", strings.Join(children, ", "), name),
+	}}
+}
+
+// taskPredecessors returns the names of the tasks a task runs after, including
+// the root task it finalizes.
+func taskPredecessors(after []interface{}, finalize string) []string {
+	var names []string
+	for _, name := range after {
+		if s, ok := name.(string); ok && s != "" {
+			names = append(names, s)
+		}
+	}
+	if finalize != "" {
+		names = append(names, finalize)
+	}
+	return names
+}
+
+// splitTaskName resolves a possibly qualified task name against the database
+// and schema of the task that references it. Names that do not parse as
+// identifiers are used as they are.
+func splitTaskName(name, database, schemaName string) (string, string, string) {
+	parts, err := identifiers.ParseIdentifier(name)
+	if err != nil {
+		return database, schemaName, name
+	}
+	switch len(parts) {
+	case 3:
+		return parts[0], parts[1], parts[2]
+	case 2:
+		return database, parts[0], parts[1]
+	default:
+		return database, schemaName, parts[0]
+	}
+}
+
+// lookupTaskId returns the OVH ID of the named task.
+func lookupTaskId(config *Config, database, schemaName, name string) (string, error) {
+	task := identifiers.NewSchemaObjectIdentifier(database, schemaName, name)
+
+	var ids []string
+	err := config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/task?database=%s&schema=%s&name=%s",
+		url.QueryEscape(database), url.QueryEscape(schemaName), url.QueryEscape(name)), &ids)
+	if err != nil {
+		return "", fmt.Errorf("failed to look up task %s: %w", task.FullyQualifiedName(), err)
+	}
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("task %s not found", task.FullyQualifiedName())
+	case 1:
+		return ids[0], nil
+	default:
+		return "", fmt.Errorf("%d tasks match %s", len(ids), task.FullyQualifiedName())
+	}
+}
+
+// taskGraphRoots follows predecessors up the task graph and returns the IDs of
+// the root tasks they lead to, without duplicates.
+func taskGraphRoots(config *Config, database, schemaName string, predecessors []string) ([]string, error) {
+	type taskRef struct{ database, schema, name string }
+
+	var queue []taskRef
+	for _, name := range predecessors {
+		db, sc, n := splitTaskName(name, database, schemaName)
+		queue = append(queue, taskRef{db, sc, n})
+	}
+
+	var roots []string
+	visited := map[string]bool{}
+	for len(queue) > 0 {
+		ref := queue[0]
+		queue = queue[1:]
+
+		key := strings.ToUpper(ref.database + "." + ref.schema + "." + ref.name)
+		if visited[key] {
+			continue
+		}
+		visited[key] = true
+
+		taskId, err := lookupTaskId(config, ref.database, ref.schema, ref.name)
+		if err != nil {
+			return nil, err
+		}
+
+		var task map[string]interface{}
+		if err := config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/task/%s", taskId), &task); err != nil {
+			return nil, fmt.Errorf("failed to read task %s: %w", key, err)
+		}
+
+		after, _ := task["after"].([]interface{})
+		finalize, _ := task["finalize"].(string)
+		parents := taskPredecessors(after, finalize)
+		if len(parents) == 0 {
+			roots = append(roots, taskId)
+			continue
+		}
+		for _, name := range parents {
+			db, sc, n := splitTaskName(name, ref.database, ref.schema)
+			queue = append(queue, taskRef{db, sc, n})
+		}
+	}
+
+	return roots, nil
+}
+
+// withSuspendedTaskRoots suspends the started root tasks among rootIds, runs
+// fn and resumes the roots it suspended. started overrides, by ID, whether a
+// root is started afterwards: a root that is disabled or deleted stays
+// suspended, and one that is enabled is resumed once fn succeeds, even if it
+// was not started before. Roots are resumed even if fn fails so the graph is
+// not left suspended.
+func withSuspendedTaskRoots(ctx context.Context, config *Config, rootIds []string, started map[string]bool, fn func() error) error {
+	var suspended []string
+	seen := map[string]bool{}
+
+	resume := func(ids []string) error {
+		var errs []error
+		for i := len(ids) - 1; i >= 0; i-- {
+			tflog.Debug(ctx, "Resuming Snowflake root task", map[string]interface{}{"id": ids[i]})
+			if err := config.OVHClient.Post(fmt.Sprintf("/cloud/project/snowflake/task/%s/resume", ids[i]), nil, nil); err != nil {
+				errs = append(errs, fmt.Errorf("failed to resume root task %s: %w", ids[i], err))
+			}
+		}
+		return errors.Join(errs...)
+	}
+	restore := func() error {
+		var ids []string
+		for _, id := range suspended {
+			if start, ok := started[id]; !ok || start {
+				ids = append(ids, id)
+			}
+		}
+		return resume(ids)
+	}
+
+	for _, rootId := range rootIds {
+		if seen[rootId] {
+			continue
+		}
+		seen[rootId] = true
+
+		state, err := taskState(config, rootId)
+		if err != nil {
+			return errors.Join(err, restore())
+		}
+		if !strings.EqualFold(state, "started") {
+			continue
+		}
+
+		tflog.Debug(ctx, "Suspending Snowflake root task", map[string]interface{}{"id": rootId})
+		if err := config.OVHClient.Post(fmt.Sprintf("/cloud/project/snowflake/task/%s/suspend", rootId), nil, nil); err != nil {
+			return errors.Join(fmt.Errorf("failed to suspend root task %s: %w", rootId, err), restore())
+		}
+		suspended = append(suspended, rootId)
+	}
+
+	if err := fn(); err != nil {
+		return errors.Join(err, restore())
+	}
+	if err := restore(); err != nil {
+		return err
+	}
+
+	// Roots that are enabled but were not started before are resumed last,
+	// once the rest of their graph is in place.
+	var enabled []string
+	for _, rootId := range rootIds {
+		if !started[rootId] || slices.Contains(suspended, rootId) || slices.Contains(enabled, rootId) {
+			continue
+		}
+		state, err := taskState(config, rootId)
+		if err != nil {
+			return err
+		}
+		if !strings.EqualFold(state, "started") {
+			enabled = append(enabled, rootId)
+		}
+	}
+	return resume(enabled)
+}
+
+// taskState returns the state of a task, started or suspended.
+func taskState(config *Config, taskId string) (string, error) {
+	var task map[string]interface{}
+	if err := config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/task/%s", taskId), &task); err != nil {
+		return "", fmt.Errorf("failed to read root task %s: %w", taskId, err)
+	}
+	state, _ := task["state"].(string)
+	return state, nil
+}
+
+// taskChildren returns the names of the tasks in a schema that run after the
+// named task or finalize it.
+func taskChildren(config *Config, database, schemaName, name string) ([]string, error) {
+	var ids []string
+	err := config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/task?database=%s&schema=%s",
+		url.QueryEscape(database), url.QueryEscape(schemaName)), &ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks in %s: %w", identifiers.NewDatabaseObjectIdentifier(database, schemaName).FullyQualifiedName(), err)
+	}
+
+	parent := strings.ToUpper(database + "." + schemaName + "." + name)
+	var children []string
+	for _, id := range ids {
+		var task map[string]interface{}
+		if err := config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/task/%s", id), &task); err != nil {
+			return nil, fmt.Errorf("failed to read task %s: %w", id, err)
+		}
+
+		after, _ := task["after"].([]interface{})
+		finalize, _ := task["finalize"].(string)
+		for _, predecessor := range taskPredecessors(after, finalize) {
+			db, sc, n := splitTaskName(predecessor, database, schemaName)
+			if strings.ToUpper(db+"."+sc+"."+n) == parent {
+				taskName, _ := task["name"].(string)
+				children = append(children, taskName)
+				break
+			}
+		}
+	}
+	return children, nil
 }
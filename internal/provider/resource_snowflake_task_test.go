@@ -0,0 +1,236 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestSplitTaskName(t *testing.T) {
+	testCases := map[string]struct {
+		name     string
+		expected [3]string
+	}{
+		"bare":            {name: "ROOT", expected: [3]string{"DB", "SCH", "ROOT"}},
+		"schema_scoped":   {name: "OTHER.ROOT", expected: [3]string{"DB", "OTHER", "ROOT"}},
+		"fully_qualified": {name: "X.Y.ROOT", expected: [3]string{"X", "Y", "ROOT"}},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			database, schemaName, task := splitTaskName(tc.name, "DB", "SCH")
+			if got := [3]string{database, schemaName, task}; got != tc.expected {
+				t.Errorf("expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestTaskPredecessors(t *testing.T) {
+	after := []interface{}{"A", "", "B"}
+
+	if got := taskPredecessors(after, ""); !reflect.DeepEqual(got, []string{"A", "B"}) {
+		t.Errorf("unexpected predecessors %v", got)
+	}
+	if got := taskPredecessors(nil, "ROOT"); !reflect.DeepEqual(got, []string{"ROOT"}) {
+		t.Errorf("unexpected predecessors %v", got)
+	}
+	if got := taskPredecessors(nil, ""); got != nil {
+		t.Errorf("expected no predecessors, got %v", got)
+	}
+}
+
+func TestLookupTaskId(t *testing.T) {
+	const lookup = "GET /cloud/project/snowflake/task?database=DB&schema=SCH&name=ROOT"
+
+	testCases := map[string]struct {
+		ids     []string
+		want    string
+		wantErr bool
+	}{
+		"found":     {ids: []string{"root"}, want: "root"},
+		"not_found": {ids: []string{}, wantErr: true},
+		"ambiguous": {ids: []string{"root", "other"}, wantErr: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, config := newFakeOVHAPI(t, map[string]interface{}{lookup: tc.ids})
+
+			got, err := lookupTaskId(config, "DB", "SCH", "ROOT")
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("expected error %t, got %v", tc.wantErr, err)
+			}
+			if got != tc.want {
+				t.Errorf("expected %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
+
+const (
+	suspendRoot = "POST /cloud/project/snowflake/task/root/suspend"
+	resumeRoot  = "POST /cloud/project/snowflake/task/root/resume"
+)
+
+// fakeTaskGraph serves a graph of two tasks in DB.SCH: ROOT, with OVH ID root,
+// and CHILD, with OVH ID child, which runs after it. The state of ROOT follows
+// the suspend and resume calls, and changing either task while ROOT is
+// started fails, as it does in Snowflake.
+func fakeTaskGraph(t *testing.T, rootState string) (*fakeOVHAPI, *Config) {
+	api, config := newFakeOVHAPI(t, nil)
+	root := map[string]interface{}{"id": "root", "name": "ROOT", "database": "DB", "schema": "SCH", "state": rootState}
+	child := map[string]interface{}{"id": "child", "name": "CHILD", "database": "DB", "schema": "SCH", "after": []string{"ROOT"}, "state": "started"}
+
+	changeGraph := func(map[string]interface{}) interface{} {
+		if root["state"] == "started" {
+			return fakeOVHError(409)
+		}
+		return nil
+	}
+
+	api.route("GET /cloud/project/snowflake/task?database=DB&schema=SCH&name=ROOT", []string{"root"})
+	api.route("GET /cloud/project/snowflake/task?database=DB&schema=SCH", []string{"root", "child"})
+	api.route("GET /cloud/project/snowflake/task/root", func(map[string]interface{}) interface{} { return root })
+	api.route("GET /cloud/project/snowflake/task/child", func(map[string]interface{}) interface{} { return child })
+	api.route("PUT /cloud/project/snowflake/task/root", func(map[string]interface{}) interface{} { return nil })
+	api.route("PUT /cloud/project/snowflake/task/child", changeGraph)
+	api.route("DELETE /cloud/project/snowflake/task/root", func(map[string]interface{}) interface{} { return nil })
+	api.route("DELETE /cloud/project/snowflake/task/child", changeGraph)
+	api.route(suspendRoot, func(map[string]interface{}) interface{} {
+		root["state"] = "suspended"
+		return nil
+	})
+	api.route(resumeRoot, func(map[string]interface{}) interface{} {
+		root["state"] = "started"
+		return nil
+	})
+	return api, config
+}
+
+// taskInstanceState returns the state of the task with the given OVH ID in DB.SCH.
+func taskInstanceState(id string, attributes map[string]string) *terraform.InstanceState {
+	attributes["id"] = id
+	attributes["database"] = "DB"
+	attributes["schema"] = "SCH"
+	attributes["sql_statement"] = "SELECT 1"
+	return &terraform.InstanceState{ID: id, Attributes: attributes}
+}
+
+// changes returns the calls that change something, in order.
+func changes(calls []string) []string {
+	var changed []string
+	for _, call := range calls {
+		if !strings.HasPrefix(call, "GET ") {
+			changed = append(changed, call)
+		}
+	}
+	return changed
+}
+
+func TestResourceSnowflakeTaskUpdate_GraphOrder(t *testing.T) {
+	tests := []struct {
+		name      string
+		rootState string
+		id        string
+		state     map[string]string
+		config    map[string]interface{}
+		want      []string
+	}{
+		{
+			name:      "child of a started root",
+			rootState: "started",
+			id:        "child",
+			state:     map[string]string{"name": "CHILD", "after.#": "1", "after.0": "ROOT", "enabled": "true"},
+			config:    map[string]interface{}{"name": "CHILD", "after": []interface{}{"ROOT"}, "enabled": true},
+			want:      []string{suspendRoot, "PUT /cloud/project/snowflake/task/child", resumeRoot},
+		},
+		{
+			name:      "child of a suspended root",
+			rootState: "suspended",
+			id:        "child",
+			state:     map[string]string{"name": "CHILD", "after.#": "1", "after.0": "ROOT", "enabled": "true"},
+			config:    map[string]interface{}{"name": "CHILD", "after": []interface{}{"ROOT"}, "enabled": true},
+			want:      []string{"PUT /cloud/project/snowflake/task/child"},
+		},
+		{
+			name:      "root being enabled",
+			rootState: "suspended",
+			id:        "root",
+			state:     map[string]string{"name": "ROOT", "enabled": "false"},
+			config:    map[string]interface{}{"name": "ROOT", "enabled": true},
+			want:      []string{"PUT /cloud/project/snowflake/task/root", resumeRoot},
+		},
+		{
+			name:      "root being disabled",
+			rootState: "started",
+			id:        "root",
+			state:     map[string]string{"name": "ROOT", "enabled": "true"},
+			config:    map[string]interface{}{"name": "ROOT", "enabled": false},
+			want:      []string{suspendRoot, "PUT /cloud/project/snowflake/task/root"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			api, config := fakeTaskGraph(t, tt.rootState)
+			r := resourceSnowflakeTask()
+			state := taskInstanceState(tt.id, tt.state)
+
+			tt.config["database"] = "DB"
+			tt.config["schema"] = "SCH"
+			tt.config["sql_statement"] = "SELECT 2"
+			diff, err := r.Diff(ctx, state, terraform.NewResourceConfigRaw(tt.config), config)
+			if err != nil {
+				t.Fatalf("diff failed: %s", err)
+			}
+			if _, diags := r.Apply(ctx, state, diff, config); diags.HasError() {
+				t.Fatalf("update failed: %v", diags)
+			}
+			if got := changes(api.recorded()); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("calls = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResourceSnowflakeTaskDelete_GraphOrder(t *testing.T) {
+	t.Run("child", func(t *testing.T) {
+		api, config := fakeTaskGraph(t, "started")
+		d := resourceSnowflakeTask().Data(taskInstanceState("child", map[string]string{"name": "CHILD", "after.#": "1", "after.0": "ROOT"}))
+
+		if diags := resourceSnowflakeTaskDelete(context.Background(), d, config); len(diags) > 0 {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		want := []string{suspendRoot, "DELETE /cloud/project/snowflake/task/child", resumeRoot}
+		if got := changes(api.recorded()); !reflect.DeepEqual(got, want) {
+			t.Errorf("calls = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("root", func(t *testing.T) {
+		api, config := fakeTaskGraph(t, "started")
+		d := resourceSnowflakeTask().Data(taskInstanceState("root", map[string]string{"name": "ROOT"}))
+
+		diags := resourceSnowflakeTaskDelete(context.Background(), d, config)
+		if diags.HasError() {
+			t.Fatalf("delete failed: %v", diags)
+		}
+
+		// The root is suspended first and never resumed.
+		want := []string{suspendRoot, "DELETE /cloud/project/snowflake/task/root"}
+		if got := changes(api.recorded()); !reflect.DeepEqual(got, want) {
+			t.Errorf("calls = %q, want %q", got, want)
+		}
+
+		// Its detached children are reported.
+		if len(diags) != 1 || diags[0].Severity != diag.Warning || !strings.Contains(diags[0].Detail, "CHILD") {
+			t.Errorf("diagnostics = %v, want a warning naming CHILD", diags)
+		}
+	})
+}
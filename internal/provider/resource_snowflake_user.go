@@ -4,35 +4,90 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/swcstudio/terraform-provider-ovh.snowflake/internal/sdk/identifiers"
 )
 
 func resourceSnowflakeUser() *schema.Resource {
 	return &schema.Resource{
 		Description: "Manages a Snowflake user",
 
+		CustomizeDiff: resourceSnowflakeUserCustomizeDiff,
+
 		CreateContext: resourceSnowflakeUserCreate,
 		ReadContext:   resourceSnowflakeUserRead,
 		UpdateContext: resourceSnowflakeUserUpdate,
 		DeleteContext: resourceSnowflakeUserDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateLookup("/cloud/project/snowflake/user", "name"),
 		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "Username",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Username",
+				ValidateFunc: identifiers.ValidateNameFunc,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "PERSON",
+				Description:  "User type: PERSON, SERVICE or LEGACY_SERVICE. SERVICE users cannot have a password, first or last name, or must_change_password",
+				ValidateFunc: validation.StringInSlice(userTypes, false),
 			},
 			"password": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"password_wo"},
+				Deprecated:    "Use password_wo and password_wo_version to keep the password out of plan and state.",
+				Description:   "User password. The value is stored in state; prefer password_wo",
+			},
+			"password_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				WriteOnly:     true,
+				ConflictsWith: []string{"password"},
+				RequiredWith:  []string{"password_wo_version"},
+				Description:   "Write-only user password, never stored in plan or state. Change password_wo_version to apply a new value. Requires Terraform 1.11 or later",
+			},
+			"password_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"password_wo"},
+				Description:  "Version of password_wo. The password is only sent on create and when this value changes",
+			},
+			"rsa_public_key": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "RSA public key for key pair authentication",
+				ValidateFunc:     validateRSAPublicKey,
+				DiffSuppressFunc: suppressRSAPublicKeyDiff,
+			},
+			"rsa_public_key_2": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Second RSA public key, used to rotate keys without downtime",
+				ValidateFunc:     validateRSAPublicKey,
+				DiffSuppressFunc: suppressRSAPublicKeyDiff,
+			},
+			"rsa_public_key_fingerprint": {
 				Type:        schema.TypeString,
-				Optional:    true,
-				Sensitive:   true,
-				Description: "User password",
+				Computed:    true,
+				Description: "SHA-256 fingerprint of rsa_public_key",
+			},
+			"rsa_public_key_2_fingerprint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA-256 fingerprint of rsa_public_key_2",
 			},
 			"login_name": {
 				Type:        schema.TypeString,
@@ -122,9 +177,17 @@ func resourceSnowflakeUserCreate(ctx context.Context, d *schema.ResourceData, me
 	config := meta.(*Config)
 	_ = diag.Diagnostics{}
 
+	password, diags := userPasswordFromConfig(d)
+	if diags.HasError() {
+		return diags
+	}
+
 	userConfig := map[string]interface{}{
 		"name":               d.Get("name").(string),
-		"password":           d.Get("password").(string),
+		"type":               d.Get("type").(string),
+		"password":           password,
+		"rsaPublicKey":       normalizeRSAPublicKey(d.Get("rsa_public_key").(string)),
+		"rsaPublicKey2":      normalizeRSAPublicKey(d.Get("rsa_public_key_2").(string)),
 		"loginName":          d.Get("login_name").(string),
 		"displayName":        d.Get("display_name").(string),
 		"firstName":          d.Get("first_name").(string),
@@ -159,32 +222,37 @@ func resourceSnowflakeUserRead(ctx context.Context, d *schema.ResourceData, meta
 
 	var user map[string]interface{}
 	err := config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/user/%s", userId), &user)
-	if err != nil {
+	if isNotFoundError(err) {
 		d.SetId("")
-		return diag.FromErr(fmt.Errorf("failed to read Snowflake user: %w", err))
+		return nil
 	}
-
-	d.Set("name", user["name"])
-	d.Set("login_name", user["loginName"])
-	d.Set("display_name", user["displayName"])
-	d.Set("first_name", user["firstName"])
-	d.Set("last_name", user["lastName"])
-	d.Set("email", user["email"])
-	d.Set("must_change_password", user["mustChangePassword"])
-	d.Set("disabled", user["disabled"])
-	d.Set("default_warehouse", user["defaultWarehouse"])
-	d.Set("default_namespace", user["defaultNamespace"])
-	d.Set("default_role", user["defaultRole"])
-	d.Set("comment", user["comment"])
-	d.Set("created_on", user["createdOn"])
-	d.Set("login_name_computed", user["loginNameComputed"])
-	d.Set("display_name_computed", user["displayNameComputed"])
-
-	if tags, ok := user["tags"].(map[string]interface{}); ok {
-		d.Set("tags", tags)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read Snowflake user: %w", err))
 	}
 
-	return nil
+	return setAPIFields(d, resourceSnowflakeUser().Schema, user, map[string]string{
+		"name":                         "name",
+		"type":                         "type",
+		"rsa_public_key":               "rsaPublicKey",
+		"rsa_public_key_2":             "rsaPublicKey2",
+		"rsa_public_key_fingerprint":   "rsaPublicKeyFp",
+		"rsa_public_key_2_fingerprint": "rsaPublicKey2Fp",
+		"login_name":                   "loginName",
+		"display_name":                 "displayName",
+		"first_name":                   "firstName",
+		"last_name":                    "lastName",
+		"email":                        "email",
+		"must_change_password":         "mustChangePassword",
+		"disabled":                     "disabled",
+		"default_warehouse":            "defaultWarehouse",
+		"default_namespace":            "defaultNamespace",
+		"default_role":                 "defaultRole",
+		"comment":                      "comment",
+		"created_on":                   "createdOn",
+		"login_name_computed":          "loginNameComputed",
+		"display_name_computed":        "displayNameComputed",
+		"tags":                         "tags",
+	})
 }
 
 func resourceSnowflakeUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -193,11 +261,24 @@ func resourceSnowflakeUserUpdate(ctx context.Context, d *schema.ResourceData, me
 
 	userId := d.Id()
 
-	if d.HasChanges("password", "login_name", "display_name", "first_name", "last_name", "email", "must_change_password", "disabled", "default_warehouse", "default_namespace", "default_role", "comment", "tags") {
+	if d.HasChanges("type", "password", "password_wo_version", "rsa_public_key", "rsa_public_key_2", "login_name", "display_name", "first_name", "last_name", "email", "must_change_password", "disabled", "default_warehouse", "default_namespace", "default_role", "comment", "tags") {
 		updateConfig := map[string]interface{}{}
 
-		if d.HasChange("password") {
-			updateConfig["password"] = d.Get("password").(string)
+		if d.HasChange("type") {
+			updateConfig["type"] = d.Get("type").(string)
+		}
+		if d.HasChanges("password", "password_wo_version") {
+			password, diags := userPasswordFromConfig(d)
+			if diags.HasError() {
+				return diags
+			}
+			updateConfig["password"] = password
+		}
+		// Both keys are sent together so that a rotation never leaves the
+		// user without a valid key.
+		if d.HasChanges("rsa_public_key", "rsa_public_key_2") {
+			updateConfig["rsaPublicKey"] = normalizeRSAPublicKey(d.Get("rsa_public_key").(string))
+			updateConfig["rsaPublicKey2"] = normalizeRSAPublicKey(d.Get("rsa_public_key_2").(string))
 		}
 		if d.HasChange("login_name") {
 			updateConfig["loginName"] = d.Get("login_name").(string)
@@ -259,3 +340,63 @@ func resourceSnowflakeUserDelete(ctx context.Context, d *schema.ResourceData, me
 	d.SetId("")
 	return nil
 }
+
+// resourceSnowflakeUserCustomizeDiff rejects attributes that the user type
+// does not allow.
+func resourceSnowflakeUserCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("type") {
+		return nil
+	}
+
+	var configured []string
+	if !d.GetRawConfig().IsNull() {
+		if value := d.GetRawConfig().GetAttr("password_wo"); value.IsKnown() && !value.IsNull() {
+			configured = append(configured, "password")
+		}
+	}
+	for _, attribute := range serviceUserForbiddenAttributes {
+		switch value := d.Get(attribute).(type) {
+		case string:
+			if value != "" {
+				configured = append(configured, attribute)
+			}
+		case bool:
+			if value {
+				configured = append(configured, attribute)
+			}
+		}
+	}
+	return checkUserType(d.Get("type").(string), configured)
+}
+
+func validateRSAPublicKey(v interface{}, k string) ([]string, []error) {
+	if _, err := rsaPublicKeyFingerprint(v.(string)); err != nil {
+		return nil, []error{fmt.Errorf("%s: %w", k, err)}
+	}
+	return nil, nil
+}
+
+// suppressRSAPublicKeyDiff ignores differences in PEM armor and line breaks.
+func suppressRSAPublicKeyDiff(k, old, new string, d *schema.ResourceData) bool {
+	return normalizeRSAPublicKey(old) == normalizeRSAPublicKey(new)
+}
+
+// userPasswordFromConfig returns the password to send to the API, taken
+// from password or, since write-only values never reach d.Get, from the raw
+// configuration of password_wo.
+func userPasswordFromConfig(d *schema.ResourceData) (string, diag.Diagnostics) {
+	if password := d.Get("password").(string); password != "" {
+		return password, nil
+	}
+	return writeOnlyString(d, "password_wo")
+}
+
+// writeOnlyString returns the configured value of a write-only string
+// attribute, or "" when it is not set.
+func writeOnlyString(d *schema.ResourceData, key string) (string, diag.Diagnostics) {
+	value, diags := d.GetRawConfigAt(cty.GetAttrPath(key))
+	if diags.HasError() || !value.IsKnown() || value.IsNull() || !value.Type().Equals(cty.String) {
+		return "", diags
+	}
+	return value.AsString(), diags
+}
@@ -0,0 +1,9 @@
+package provider
+
+import "testing"
+
+func TestResourceSnowflakeUserSchema(t *testing.T) {
+	if err := resourceSnowflakeUser().InternalValidate(nil, true); err != nil {
+		t.Fatalf("schema validation failed: %s", err)
+	}
+}
@@ -7,37 +7,53 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/swcstudio/terraform-provider-ovh.snowflake/internal/sdk/identifiers"
 )
 
 func resourceSnowflakeWarehouse() *schema.Resource {
 	return &schema.Resource{
 		Description: "Manages a Snowflake warehouse with OVH infrastructure optimization",
 
+		CustomizeDiff: resourceSnowflakeWarehouseCustomizeDiff,
+
 		CreateContext: resourceSnowflakeWarehouseCreate,
 		ReadContext:   resourceSnowflakeWarehouseRead,
 		UpdateContext: resourceSnowflakeWarehouseUpdate,
 		DeleteContext: resourceSnowflakeWarehouseDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateLookup("/cloud/project/snowflake/warehouse", "name"),
 		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "Name of the warehouse",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the warehouse",
+				ValidateFunc: identifiers.ValidateNameFunc,
 			},
 			"size": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "X-SMALL",
-				Description: "Size of the warehouse",
-				ValidateFunc: validation.StringInSlice([]string{
-					"X-SMALL", "SMALL", "MEDIUM", "LARGE", "X-LARGE",
-					"2X-LARGE", "3X-LARGE", "4X-LARGE", "5X-LARGE", "6X-LARGE",
-				}, false),
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "X-SMALL",
+				Description:  "Size of the warehouse",
+				ValidateFunc: validation.StringInSlice(warehouseSizes, false),
+			},
+			"warehouse_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "STANDARD",
+				Description:  "Warehouse type (STANDARD, SNOWPARK-OPTIMIZED). A running warehouse is suspended while its type changes and resumed afterwards",
+				ValidateFunc: validation.StringInSlice(warehouseTypes, false),
+			},
+			"resource_constraint": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Memory and CPU architecture of a Snowpark-optimized warehouse, or generation of a standard warehouse",
+				ValidateFunc: validation.StringInSlice(warehouseResourceConstraints, false),
 			},
 			"max_cluster_count": {
 				Type:         schema.TypeInt,
@@ -57,8 +73,8 @@ func resourceSnowflakeWarehouse() *schema.Resource {
 				Type:         schema.TypeInt,
 				Optional:     true,
 				Default:      60,
-				Description:  "Auto suspend time in seconds",
-				ValidateFunc: validation.IntAtLeast(60),
+				Description:  "Auto suspend time in seconds, 0 to never suspend or at least 60",
+				ValidateFunc: validation.Any(validation.IntInSlice([]int{0}), validation.IntAtLeast(60)),
 			},
 			"auto_resume": {
 				Type:        schema.TypeBool,
@@ -72,14 +88,44 @@ func resourceSnowflakeWarehouse() *schema.Resource {
 				Default:     false,
 				Description: "Initially suspend warehouse",
 			},
-			"scaling_policy": {
-				Type:        schema.TypeString,
+			"suspended": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the warehouse should be suspended. Changing it suspends or resumes the warehouse; suspensions by auto_suspend are not reported as drift",
+			},
+			"wait_for_completion": {
+				Type:        schema.TypeBool,
 				Optional:    true,
-				Default:     "STANDARD",
-				Description: "Scaling policy",
-				ValidateFunc: validation.StringInSlice([]string{
-					"STANDARD", "ECONOMY",
-				}, false),
+				Default:     false,
+				Description: "Wait for resizes to provision the new compute resources",
+			},
+			"enable_query_acceleration": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Enable the query acceleration service",
+			},
+			"query_acceleration_max_scale_factor": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      8,
+				Description:  "Query acceleration scale factor limit, 0 for no limit",
+				ValidateFunc: validation.IntBetween(0, 100),
+			},
+			"max_concurrency_level": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      8,
+				Description:  "Concurrent SQL statements before queuing",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"scaling_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "STANDARD",
+				Description:  "Scaling policy of a multi-cluster warehouse (STANDARD, ECONOMY)",
+				ValidateFunc: validation.StringInSlice(warehouseScalingPolicies, false),
 			},
 			"resource_monitor": {
 				Type:        schema.TypeString,
@@ -126,6 +172,7 @@ func resourceSnowflakeWarehouse() *schema.Resource {
 				Type:        schema.TypeString,
 				Computed:    true,
 				Description: "Warehouse type",
+				Deprecated:  "Use warehouse_type instead.",
 			},
 			"created_on": {
 				Type:        schema.TypeString,
@@ -143,6 +190,7 @@ func resourceSnowflakeWarehouseCreate(ctx context.Context, d *schema.ResourceDat
 	warehouseConfig := map[string]interface{}{
 		"name":                d.Get("name").(string),
 		"size":                d.Get("size").(string),
+		"type":                d.Get("warehouse_type").(string),
 		"maxClusterCount":     d.Get("max_cluster_count").(int),
 		"minClusterCount":     d.Get("min_cluster_count").(int),
 		"autoSuspend":         d.Get("auto_suspend").(int),
@@ -155,6 +203,13 @@ func resourceSnowflakeWarehouseCreate(ctx context.Context, d *schema.ResourceDat
 		"costTracking":        d.Get("cost_tracking").(bool),
 		"performanceInsights": d.Get("performance_insights").(bool),
 		"tags":                d.Get("tags"),
+
+		"enableQueryAcceleration":         d.Get("enable_query_acceleration").(bool),
+		"queryAccelerationMaxScaleFactor": d.Get("query_acceleration_max_scale_factor").(int),
+		"maxConcurrencyLevel":             d.Get("max_concurrency_level").(int),
+	}
+	if v, ok := d.GetOk("resource_constraint"); ok {
+		warehouseConfig["resourceConstraint"] = v.(string)
 	}
 
 	var result map[string]interface{}
@@ -166,6 +221,14 @@ func resourceSnowflakeWarehouseCreate(ctx context.Context, d *schema.ResourceDat
 	warehouseId := result["id"].(string)
 	d.SetId(warehouseId)
 
+	// initially_suspended wins over suspended on creation; bring the
+	// warehouse to the state suspended asks for.
+	if suspended := d.GetRawConfig().GetAttr("suspended"); !suspended.IsNull() && suspended.IsKnown() {
+		if err := setWarehouseSuspended(ctx, config, warehouseId, suspended.True()); err != nil {
+			return diag.FromErr(fmt.Errorf("failed to change the state of Snowflake warehouse: %w", err))
+		}
+	}
+
 	return resourceSnowflakeWarehouseRead(ctx, d, meta)
 }
 
@@ -177,33 +240,49 @@ func resourceSnowflakeWarehouseRead(ctx context.Context, d *schema.ResourceData,
 
 	var warehouse map[string]interface{}
 	err := config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/warehouse/%s", warehouseId), &warehouse)
-	if err != nil {
+	if isNotFoundError(err) {
 		d.SetId("")
+		return nil
+	}
+	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to read Snowflake warehouse: %w", err))
 	}
 
-	d.Set("name", warehouse["name"])
-	d.Set("size", warehouse["size"])
-	d.Set("max_cluster_count", warehouse["maxClusterCount"])
-	d.Set("min_cluster_count", warehouse["minClusterCount"])
-	d.Set("auto_suspend", warehouse["autoSuspend"])
-	d.Set("auto_resume", warehouse["autoResume"])
-	d.Set("initially_suspended", warehouse["initiallySuspended"])
-	d.Set("scaling_policy", warehouse["scalingPolicy"])
-	d.Set("resource_monitor", warehouse["resourceMonitor"])
-	d.Set("comment", warehouse["comment"])
-	d.Set("ovh_optimization", warehouse["ovhOptimization"])
-	d.Set("cost_tracking", warehouse["costTracking"])
-	d.Set("performance_insights", warehouse["performanceInsights"])
-	d.Set("state", warehouse["state"])
-	d.Set("type", warehouse["type"])
-	d.Set("created_on", warehouse["createdOn"])
-
-	if tags, ok := warehouse["tags"].(map[string]interface{}); ok {
-		d.Set("tags", tags)
+	// suspended is the desired state and only follows the reported state
+	// when it is not known yet, e.g. on import or right after creation.
+	if state := d.GetRawState(); state.IsNull() || state.GetAttr("suspended").IsNull() {
+		if suspended := warehouseSuspended(warehouse); !suspended.IsNull() {
+			if err := d.Set("suspended", suspended.ValueBool()); err != nil {
+				return diag.FromErr(fmt.Errorf("failed to set suspended: %w", err))
+			}
+		}
 	}
 
-	return nil
+	return setAPIFields(d, resourceSnowflakeWarehouse().Schema, warehouse, map[string]string{
+		"name":                 "name",
+		"size":                 "size",
+		"warehouse_type":       "type",
+		"resource_constraint":  "resourceConstraint",
+		"max_cluster_count":    "maxClusterCount",
+		"min_cluster_count":    "minClusterCount",
+		"auto_suspend":         "autoSuspend",
+		"auto_resume":          "autoResume",
+		"initially_suspended":  "initiallySuspended",
+		"scaling_policy":       "scalingPolicy",
+		"resource_monitor":     "resourceMonitor",
+		"comment":              "comment",
+		"ovh_optimization":     "ovhOptimization",
+		"cost_tracking":        "costTracking",
+		"performance_insights": "performanceInsights",
+		"state":                "state",
+		"type":                 "type",
+		"created_on":           "createdOn",
+		"tags":                 "tags",
+
+		"enable_query_acceleration":           "enableQueryAcceleration",
+		"query_acceleration_max_scale_factor": "queryAccelerationMaxScaleFactor",
+		"max_concurrency_level":               "maxConcurrencyLevel",
+	})
 }
 
 func resourceSnowflakeWarehouseUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -212,11 +291,33 @@ func resourceSnowflakeWarehouseUpdate(ctx context.Context, d *schema.ResourceDat
 
 	warehouseId := d.Id()
 
-	if d.HasChanges("size", "max_cluster_count", "min_cluster_count", "auto_suspend", "auto_resume", "scaling_policy", "resource_monitor", "comment", "tags") {
+	suspendBefore, resumeAfter, err := warehouseSuspension(config, warehouseId, d.HasChange("suspended"), d.Get("suspended").(bool),
+		d.HasChanges("warehouse_type", "resource_constraint"))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read Snowflake warehouse state: %w", err))
+	}
+
+	if suspendBefore {
+		if err := setWarehouseSuspended(ctx, config, warehouseId, true); err != nil {
+			return diag.FromErr(fmt.Errorf("failed to suspend Snowflake warehouse: %w", err))
+		}
+	}
+
+	if d.HasChanges("size", "warehouse_type", "resource_constraint", "max_cluster_count", "min_cluster_count", "auto_suspend", "auto_resume", "scaling_policy", "resource_monitor", "comment", "tags",
+		"enable_query_acceleration", "query_acceleration_max_scale_factor", "max_concurrency_level") {
 		updateConfig := map[string]interface{}{}
 
 		if d.HasChange("size") {
 			updateConfig["size"] = d.Get("size").(string)
+			if d.Get("wait_for_completion").(bool) {
+				updateConfig["waitForCompletion"] = true
+			}
+		}
+		if d.HasChange("warehouse_type") {
+			updateConfig["type"] = d.Get("warehouse_type").(string)
+		}
+		if d.HasChange("resource_constraint") {
+			updateConfig["resourceConstraint"] = d.Get("resource_constraint").(string)
 		}
 		if d.HasChange("max_cluster_count") {
 			updateConfig["maxClusterCount"] = d.Get("max_cluster_count").(int)
@@ -242,6 +343,15 @@ func resourceSnowflakeWarehouseUpdate(ctx context.Context, d *schema.ResourceDat
 		if d.HasChange("tags") {
 			updateConfig["tags"] = d.Get("tags")
 		}
+		if d.HasChange("enable_query_acceleration") {
+			updateConfig["enableQueryAcceleration"] = d.Get("enable_query_acceleration").(bool)
+		}
+		if d.HasChange("query_acceleration_max_scale_factor") {
+			updateConfig["queryAccelerationMaxScaleFactor"] = d.Get("query_acceleration_max_scale_factor").(int)
+		}
+		if d.HasChange("max_concurrency_level") {
+			updateConfig["maxConcurrencyLevel"] = d.Get("max_concurrency_level").(int)
+		}
 
 		err := config.OVHClient.Put(fmt.Sprintf("/cloud/project/snowflake/warehouse/%s", warehouseId), updateConfig, nil)
 		if err != nil {
@@ -249,6 +359,12 @@ func resourceSnowflakeWarehouseUpdate(ctx context.Context, d *schema.ResourceDat
 		}
 	}
 
+	if resumeAfter {
+		if err := setWarehouseSuspended(ctx, config, warehouseId, false); err != nil {
+			return diag.FromErr(fmt.Errorf("failed to resume Snowflake warehouse: %w", err))
+		}
+	}
+
 	return resourceSnowflakeWarehouseRead(ctx, d, meta)
 }
 
@@ -266,3 +382,36 @@ func resourceSnowflakeWarehouseDelete(ctx context.Context, d *schema.ResourceDat
 	d.SetId("")
 	return nil
 }
+
+// resourceSnowflakeWarehouseCustomizeDiff checks the cluster settings against
+// each other and, when the provider knows the account edition, rejects
+// Enterprise features at plan time. scaling_policy has a default, so it is
+// only checked when it is configured.
+func resourceSnowflakeWarehouseCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("min_cluster_count") || !d.NewValueKnown("max_cluster_count") {
+		return nil
+	}
+
+	minClusterCount := int64(d.Get("min_cluster_count").(int))
+	maxClusterCount := int64(d.Get("max_cluster_count").(int))
+	scalingPolicySet := false
+	if !d.GetRawConfig().IsNull() {
+		scalingPolicySet = !d.GetRawConfig().GetAttr("scaling_policy").IsNull()
+	}
+
+	if err := checkWarehouseClusterCounts(minClusterCount, maxClusterCount); err != nil {
+		return err
+	}
+	if scalingPolicySet {
+		if err := checkWarehouseScalingPolicy(maxClusterCount); err != nil {
+			return err
+		}
+	}
+
+	config, ok := meta.(*Config)
+	if !ok || config.SnowflakeEdition == "" {
+		return nil
+	}
+	multiCluster := minClusterCount > 1 || maxClusterCount > 1 || scalingPolicySet
+	return checkWarehouseEdition(config.SnowflakeEdition, multiCluster, d.Get("enable_query_acceleration").(bool))
+}
@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestResourceSnowflakeWarehouseCustomizeDiff(t *testing.T) {
+	testCases := map[string]struct {
+		config  map[string]interface{}
+		wantErr bool
+	}{
+		"defaults":      {config: map[string]interface{}{}},
+		"multi_cluster": {config: map[string]interface{}{"max_cluster_count": 3, "scaling_policy": "ECONOMY"}},
+		// Diff leaves the raw config null, so scaling_policy cannot be told
+		// apart from its default and is not checked.
+		"single_cluster_policy":        {config: map[string]interface{}{"scaling_policy": "ECONOMY"}},
+		"min_above_max":                {config: map[string]interface{}{"min_cluster_count": 3, "max_cluster_count": 2}, wantErr: true},
+		"multi_cluster_without_policy": {config: map[string]interface{}{"max_cluster_count": 3}},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tc.config["name"] = "ANALYTICS_WH"
+
+			_, err := resourceSnowflakeWarehouse().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(tc.config), &Config{})
+			if (err != nil) != tc.wantErr {
+				t.Errorf("expected error %v, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
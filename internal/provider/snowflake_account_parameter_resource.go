@@ -0,0 +1,287 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = &SnowflakeAccountParameterResource{}
+	_ resource.ResourceWithValidateConfig = &SnowflakeAccountParameterResource{}
+	_ resource.ResourceWithImportState    = &SnowflakeAccountParameterResource{}
+	_ resource.ResourceWithIdentity       = &SnowflakeAccountParameterResource{}
+)
+
+var accountParameterIdentity = resourceIdentity{
+	{name: "key", description: "Name of the parameter."},
+}
+
+func NewSnowflakeAccountParameterResource() resource.Resource {
+	return &SnowflakeAccountParameterResource{}
+}
+
+type SnowflakeAccountParameterResource struct {
+	config *Config
+}
+
+type SnowflakeAccountParameterResourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	Key     types.String `tfsdk:"key"`
+	Value   types.String `tfsdk:"value"`
+	Default types.String `tfsdk:"default"`
+}
+
+func (r *SnowflakeAccountParameterResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account_parameter"
+}
+
+func (r *SnowflakeAccountParameterResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Sets a Snowflake parameter at account level on OVH infrastructure. Destroying the resource unsets the parameter, restoring the Snowflake default.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Unique identifier for the account parameter.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"key": schema.StringAttribute{
+				Description: fmt.Sprintf("Name of the parameter. Supported parameters: %s.", strings.Join(parameterKeys(parameterLevelAccount), ", ")),
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.StringAttribute{
+				Description: "Value of the parameter. Booleans and numbers are given as strings, for example \"true\" or \"3600\".",
+				Required:    true,
+			},
+			"default": schema.StringAttribute{
+				Description: "Default value the parameter returns to when the resource is destroyed.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SnowflakeAccountParameterResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = accountParameterIdentity.schema()
+}
+
+func (r *SnowflakeAccountParameterResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data SnowflakeAccountParameterResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateParameter(data.Key, data.Value, parameterLevelAccount)...)
+}
+
+func (r *SnowflakeAccountParameterResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.config = config
+}
+
+func (r *SnowflakeAccountParameterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SnowflakeAccountParameterResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Setting Snowflake account parameter", map[string]interface{}{
+		"key": data.Key.ValueString(),
+	})
+
+	resp.Diagnostics.Append(r.set(&data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("account-parameter-%s", strings.ToUpper(data.Key.ValueString())))
+
+	resp.Diagnostics.Append(r.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Set Snowflake account parameter")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(accountParameterIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
+}
+
+func (r *SnowflakeAccountParameterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SnowflakeAccountParameterResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Snowflake account parameter", map[string]interface{}{
+		"key": data.Key.ValueString(),
+	})
+
+	var parameter map[string]interface{}
+	err := r.config.OVHClient.Get(parameterEndpoint(parameterLevelAccount, "", data.Key.ValueString()), &parameter)
+	if isNotFoundError(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Snowflake Account Parameter",
+			fmt.Sprintf("Could not read account parameter %s: %s", data.Key.ValueString(), err),
+		)
+		return
+	}
+
+	// A parameter unset outside Terraform falls back to its default, which
+	// the API reports with an empty level.
+	if apiString(parameter, "level").ValueString() != parameterLevelAccount {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.refresh(parameter)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(accountParameterIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
+}
+
+func (r *SnowflakeAccountParameterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SnowflakeAccountParameterResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating Snowflake account parameter", map[string]interface{}{
+		"key": data.Key.ValueString(),
+	})
+
+	resp.Diagnostics.Append(r.set(&data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(accountParameterIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
+}
+
+func (r *SnowflakeAccountParameterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SnowflakeAccountParameterResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Unsetting Snowflake account parameter", map[string]interface{}{
+		"key": data.Key.ValueString(),
+	})
+
+	err := r.config.OVHClient.Delete(parameterEndpoint(parameterLevelAccount, "", data.Key.ValueString()), nil)
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			"Error Unsetting Snowflake Account Parameter",
+			fmt.Sprintf("Could not restore the default of account parameter %s: %s", data.Key.ValueString(), err),
+		)
+	}
+}
+
+// ImportState accepts the parameter key as the ID.
+func (r *SnowflakeAccountParameterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = accountParameterIdentity.importID(ctx, r.config, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key := strings.ToUpper(req.ID)
+	if p, ok := lookupParameter(key); !ok || !p.allowedAt(parameterLevelAccount) {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("%q is not a parameter that can be set on the account.", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), fmt.Sprintf("account-parameter-%s", key))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key"), key)...)
+}
+
+// set sends the planned value to the OVH API.
+func (r *SnowflakeAccountParameterResource) set(data *SnowflakeAccountParameterResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	err := r.config.OVHClient.Put(parameterEndpoint(parameterLevelAccount, "", data.Key.ValueString()), map[string]interface{}{
+		"value": data.Value.ValueString(),
+	}, nil)
+	if err != nil {
+		diags.AddError(
+			"Error Setting Snowflake Account Parameter",
+			fmt.Sprintf("Could not set account parameter %s: %s", data.Key.ValueString(), err),
+		)
+	}
+	return diags
+}
+
+// read refreshes data from the OVH API after a create or update.
+func (r *SnowflakeAccountParameterResource) read(ctx context.Context, data *SnowflakeAccountParameterResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var parameter map[string]interface{}
+	err := r.config.OVHClient.Get(parameterEndpoint(parameterLevelAccount, "", data.Key.ValueString()), &parameter)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Snowflake Account Parameter",
+			fmt.Sprintf("Could not read account parameter %s: %s", data.Key.ValueString(), err),
+		)
+		return diags
+	}
+
+	data.refresh(parameter)
+	return diags
+}
+
+// refresh copies an OVH API parameter into the model.
+func (m *SnowflakeAccountParameterResourceModel) refresh(parameter map[string]interface{}) {
+	m.Value = refreshParameterValue(m.Key.ValueString(), m.Value, apiString(parameter, "value").ValueString())
+	m.Default = apiString(parameter, "default")
+}
@@ -0,0 +1,400 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = &SnowflakeAuthenticationPolicyResource{}
+	_ resource.ResourceWithValidateConfig = &SnowflakeAuthenticationPolicyResource{}
+	_ resource.ResourceWithImportState    = &SnowflakeAuthenticationPolicyResource{}
+	_ resource.ResourceWithIdentity       = &SnowflakeAuthenticationPolicyResource{}
+)
+
+var authenticationPolicyIdentity = schemaObjectIdentity("authentication policy")
+
+func NewSnowflakeAuthenticationPolicyResource() resource.Resource {
+	return &SnowflakeAuthenticationPolicyResource{}
+}
+
+type SnowflakeAuthenticationPolicyResource struct {
+	config *Config
+}
+
+type SnowflakeAuthenticationPolicyResourceModel struct {
+	SnowflakeSecurityPolicyResourceModel
+
+	AuthenticationMethods    types.Set    `tfsdk:"authentication_methods"`
+	ClientTypes              types.Set    `tfsdk:"client_types"`
+	SecurityIntegrations     types.Set    `tfsdk:"security_integrations"`
+	MFAEnrollment            types.String `tfsdk:"mfa_enrollment"`
+	MFAAuthenticationMethods types.Set    `tfsdk:"mfa_authentication_methods"`
+}
+
+var (
+	authenticationMethods    = []string{"ALL", "SAML", "PASSWORD", "OAUTH", "KEYPAIR", "PROGRAMMATIC_ACCESS_TOKEN"}
+	authenticationClients    = []string{"ALL", "SNOWFLAKE_UI", "DRIVERS", "SNOWSQL", "SNOWFLAKE_CLI"}
+	mfaAuthenticationMethods = []string{"ALL", "SAML", "PASSWORD"}
+)
+
+// authenticationPolicyDefaults lists, by OVH API key, what Snowflake reports
+// for a set that was never configured.
+var authenticationPolicyDefaults = map[string][]string{
+	"authenticationMethods":    {"ALL"},
+	"clientTypes":              {"ALL"},
+	"securityIntegrations":     {"ALL"},
+	"mfaAuthenticationMethods": {"PASSWORD", "SAML"},
+}
+
+func (r *SnowflakeAuthenticationPolicyResource) api() securityPolicyAPI {
+	return securityPolicyAPI{
+		config:   r.config,
+		endpoint: "/cloud/project/snowflake/authentication-policy",
+		kind:     "authentication policy",
+		title:    "Authentication Policy",
+	}
+}
+
+func (r *SnowflakeAuthenticationPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_authentication_policy"
+}
+
+func (r *SnowflakeAuthenticationPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := securityPolicyAttributes("authentication policy")
+
+	attributes["authentication_methods"] = schema.SetAttribute{
+		Description: "Authentication methods users may log in with: ALL, SAML, PASSWORD, OAUTH, KEYPAIR or PROGRAMMATIC_ACCESS_TOKEN. When omitted, all methods are allowed.",
+		ElementType: types.StringType,
+		Optional:    true,
+		Validators: []validator.Set{
+			setOneOfValidator{values: authenticationMethods},
+		},
+	}
+	attributes["client_types"] = schema.SetAttribute{
+		Description: "Clients users may log in from: ALL, SNOWFLAKE_UI, DRIVERS, SNOWSQL or SNOWFLAKE_CLI. When omitted, all clients are allowed.",
+		ElementType: types.StringType,
+		Optional:    true,
+		Validators: []validator.Set{
+			setOneOfValidator{values: authenticationClients},
+		},
+	}
+	attributes["security_integrations"] = schema.SetAttribute{
+		Description: "Security integrations users may authenticate through with SAML or OAuth. When omitted, all integrations are allowed.",
+		ElementType: types.StringType,
+		Optional:    true,
+	}
+	attributes["mfa_enrollment"] = schema.StringAttribute{
+		Description: "Whether users must enrol in multi-factor authentication: REQUIRED or OPTIONAL (default). REQUIRED needs the Snowflake UI among the client types, since enrolment happens there.",
+		Optional:    true,
+		Computed:    true,
+		Default:     stringdefault.StaticString("OPTIONAL"),
+		Validators: []validator.String{
+			oneOfValidator{values: []string{"REQUIRED", "OPTIONAL"}},
+		},
+	}
+	attributes["mfa_authentication_methods"] = schema.SetAttribute{
+		Description: "Authentication methods that prompt for a second factor once a user is enrolled: ALL, SAML or PASSWORD. When omitted, the Snowflake default applies.",
+		ElementType: types.StringType,
+		Optional:    true,
+		Validators: []validator.Set{
+			setOneOfValidator{values: mfaAuthenticationMethods},
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a Snowflake authentication policy on OVH infrastructure, restricting login methods and clients and enforcing MFA enrolment. Attach it to the account or to users with snowflake-ovh_security_policy_attachment.",
+		Attributes:  attributes,
+	}
+}
+
+func (r *SnowflakeAuthenticationPolicyResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = authenticationPolicyIdentity.schema()
+}
+
+func (r *SnowflakeAuthenticationPolicyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data SnowflakeAuthenticationPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sets := map[string]types.Set{
+		"authentication_methods":     data.AuthenticationMethods,
+		"client_types":               data.ClientTypes,
+		"security_integrations":      data.SecurityIntegrations,
+		"mfa_authentication_methods": data.MFAAuthenticationMethods,
+	}
+	values := map[string][]string{}
+	for name, set := range sets {
+		if set.IsNull() || set.IsUnknown() {
+			continue
+		}
+		var elements []types.String
+		resp.Diagnostics.Append(set.ElementsAs(ctx, &elements, true)...)
+		values[name] = stringValues(elements)
+
+		if stringInSlice("ALL", values[name]) && len(values[name]) > 1 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Invalid Attribute Combination",
+				fmt.Sprintf("%s cannot combine ALL with other values.", name),
+			)
+		}
+	}
+
+	allows := func(name, value string) bool {
+		if sets[name].IsNull() || sets[name].IsUnknown() {
+			return true
+		}
+		return stringInSlice("ALL", values[name]) || stringInSlice(value, values[name])
+	}
+
+	if data.MFAEnrollment.ValueString() == "REQUIRED" && !allows("client_types", "SNOWFLAKE_UI") {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("client_types"),
+			"MFA Enrolment Not Possible",
+			"mfa_enrollment = \"REQUIRED\" needs SNOWFLAKE_UI (or ALL) in client_types, because users enrol in MFA through the Snowflake UI.",
+		)
+	}
+
+	for _, method := range values["mfa_authentication_methods"] {
+		if method != "ALL" && !allows("authentication_methods", method) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("mfa_authentication_methods"),
+				"Invalid MFA Authentication Method",
+				fmt.Sprintf("%s requires MFA but is not among the allowed authentication_methods.", method),
+			)
+		}
+	}
+}
+
+func (r *SnowflakeAuthenticationPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.config = config
+}
+
+func (r *SnowflakeAuthenticationPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SnowflakeAuthenticationPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, diags := data.settings(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.api().create(ctx, &data.SnowflakeSecurityPolicyResourceModel, settings)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, diags := r.api().read(&data.SnowflakeSecurityPolicyResourceModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(data.refresh(ctx, policy)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Created Snowflake authentication policy")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(authenticationPolicyIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
+}
+
+func (r *SnowflakeAuthenticationPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SnowflakeAuthenticationPolicyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Snowflake authentication policy", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	policy, err := r.api().get(&data.SnowflakeSecurityPolicyResourceModel)
+	if isNotFoundError(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Snowflake Authentication Policy",
+			fmt.Sprintf("Could not read authentication policy %s: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	data.SnowflakeSecurityPolicyResourceModel.refresh(policy)
+	resp.Diagnostics.Append(data.refresh(ctx, policy)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(authenticationPolicyIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
+}
+
+func (r *SnowflakeAuthenticationPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state SnowflakeAuthenticationPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, diags := data.settings(ctx)
+	resp.Diagnostics.Append(diags...)
+	stateSettings, diags := state.settings(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.api().update(ctx, &data.SnowflakeSecurityPolicyResourceModel, &state.SnowflakeSecurityPolicyResourceModel,
+		settings, stateSettings)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, diags := r.api().read(&data.SnowflakeSecurityPolicyResourceModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(data.refresh(ctx, policy)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(authenticationPolicyIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
+}
+
+func (r *SnowflakeAuthenticationPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SnowflakeAuthenticationPolicyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.api().delete(ctx, &data.SnowflakeSecurityPolicyResourceModel)...)
+}
+
+func (r *SnowflakeAuthenticationPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = authenticationPolicyIdentity.importID(ctx, r.config, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	importSchemaObject(ctx, r.config, r.api().kind, r.api().endpoint, req, resp)
+}
+
+// sets maps OVH API keys to the set-valued authentication policy settings.
+func (m *SnowflakeAuthenticationPolicyResourceModel) sets() map[string]*types.Set {
+	return map[string]*types.Set{
+		"authenticationMethods":    &m.AuthenticationMethods,
+		"clientTypes":              &m.ClientTypes,
+		"securityIntegrations":     &m.SecurityIntegrations,
+		"mfaAuthenticationMethods": &m.MFAAuthenticationMethods,
+	}
+}
+
+// settings returns the authentication policy settings for the OVH API. Null
+// sets are sent as nil so that the API restores their defaults.
+func (m *SnowflakeAuthenticationPolicyResourceModel) settings(ctx context.Context) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	settings := map[string]interface{}{
+		"mfaEnrollment": m.MFAEnrollment.ValueString(),
+	}
+	for key, set := range m.sets() {
+		var values []string
+		if !set.IsNull() {
+			var elements []types.String
+			diags.Append(set.ElementsAs(ctx, &elements, false)...)
+			values = stringValues(elements)
+		}
+		settings[key] = values
+	}
+	return settings, diags
+}
+
+// refresh copies the authentication policy settings of an OVH API policy
+// into the model. Sets that are not configured stay null as long as the API
+// reports their default, so that imported policies only show the settings
+// that differ from it.
+func (m *SnowflakeAuthenticationPolicyResourceModel) refresh(ctx context.Context, policy map[string]interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.MFAEnrollment = apiString(policy, "mfaEnrollment")
+	for key, set := range m.sets() {
+		values := stringValues(apiStringList(policy, key))
+		if set.IsNull() && isDefaultAuthenticationSet(values, authenticationPolicyDefaults[key]) {
+			continue
+		}
+		value, d := types.SetValueFrom(ctx, types.StringType, values)
+		diags.Append(d...)
+		*set = value
+	}
+
+	return diags
+}
+
+// isDefaultAuthenticationSet reports whether values, as reported by the API,
+// is the default of a set: empty, ALL, or the given defaults in any order.
+func isDefaultAuthenticationSet(values, defaults []string) bool {
+	if len(values) == 0 || len(values) == 1 && strings.EqualFold(values[0], "ALL") {
+		return true
+	}
+	if len(values) != len(defaults) {
+		return false
+	}
+	for _, value := range values {
+		found := false
+		for _, d := range defaults {
+			if strings.EqualFold(value, d) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
@@ -4,13 +4,27 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/swcstudio/terraform-provider-ovh.snowflake/internal/sdk/identifiers"
+)
+
+var (
+	_ resource.Resource                 = &SnowflakeDatabaseResource{}
+	_ resource.ResourceWithImportState  = &SnowflakeDatabaseResource{}
+	_ resource.ResourceWithIdentity     = &SnowflakeDatabaseResource{}
+	_ resource.ResourceWithMoveState    = &SnowflakeDatabaseResource{}
+	_ resource.ResourceWithUpgradeState = &SnowflakeDatabaseResource{}
 )
 
-var _ resource.Resource = &SnowflakeDatabaseResource{}
+var databaseIdentity = accountObjectIdentity("database")
 
 func NewSnowflakeDatabaseResource() resource.Resource {
 	return &SnowflakeDatabaseResource{}
@@ -33,14 +47,24 @@ func (r *SnowflakeDatabaseResource) Metadata(ctx context.Context, req resource.M
 func (r *SnowflakeDatabaseResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages a Snowflake database on OVH infrastructure.",
+		Version:     1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "Unique identifier for the database.",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"name": schema.StringAttribute{
 				Description: "Name of the database.",
 				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					identifiers.ValidName(),
+				},
 			},
 			"comment": schema.StringAttribute{
 				Description: "Comment for the database.",
@@ -50,6 +74,10 @@ func (r *SnowflakeDatabaseResource) Schema(ctx context.Context, req resource.Sch
 	}
 }
 
+func (r *SnowflakeDatabaseResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = databaseIdentity.schema()
+}
+
 func (r *SnowflakeDatabaseResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -79,11 +107,32 @@ func (r *SnowflakeDatabaseResource) Create(ctx context.Context, req resource.Cre
 		"name": data.Name.ValueString(),
 	})
 
-	data.ID = types.StringValue(fmt.Sprintf("database-%s", data.Name.ValueString()))
+	databaseConfig := map[string]interface{}{
+		"name":    data.Name.ValueString(),
+		"comment": data.Comment.ValueString(),
+	}
+
+	var result map[string]interface{}
+	err := r.config.OVHClient.Post("/cloud/project/snowflake/database", databaseConfig, &result)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Snowflake Database",
+			fmt.Sprintf("Could not create database %s: %s", data.Name.ValueString(), err),
+		)
+		return
+	}
+
+	data.ID = apiString(result, "id")
+
+	resp.Diagnostics.Append(r.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	tflog.Trace(ctx, "Created Snowflake database")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(databaseIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
 }
 
 func (r *SnowflakeDatabaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -98,13 +147,38 @@ func (r *SnowflakeDatabaseResource) Read(ctx context.Context, req resource.ReadR
 		"id": data.ID.ValueString(),
 	})
 
+	resp.Diagnostics.Append(resolveMissingID(r.config, "database", "/cloud/project/snowflake/database", map[string]string{
+		"name": data.Name.ValueString(),
+	}, &data.ID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var database map[string]interface{}
+	err := r.config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/database/%s", data.ID.ValueString()), &database)
+	if isNotFoundError(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Snowflake Database",
+			fmt.Sprintf("Could not read database %s: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	data.refresh(database)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(databaseIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
 }
 
 func (r *SnowflakeDatabaseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data SnowflakeDatabaseResourceModel
+	var data, state SnowflakeDatabaseResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -113,7 +187,27 @@ func (r *SnowflakeDatabaseResource) Update(ctx context.Context, req resource.Upd
 		"id": data.ID.ValueString(),
 	})
 
+	if !data.Comment.Equal(state.Comment) {
+		updateConfig := map[string]interface{}{
+			"comment": data.Comment.ValueString(),
+		}
+		err := r.config.OVHClient.Put(fmt.Sprintf("/cloud/project/snowflake/database/%s", data.ID.ValueString()), updateConfig, nil)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Snowflake Database",
+				fmt.Sprintf("Could not update database %s: %s", data.ID.ValueString(), err),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(databaseIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
 }
 
 func (r *SnowflakeDatabaseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -127,4 +221,97 @@ func (r *SnowflakeDatabaseResource) Delete(ctx context.Context, req resource.Del
 	tflog.Debug(ctx, "Deleting Snowflake database", map[string]interface{}{
 		"id": data.ID.ValueString(),
 	})
+
+	err := r.config.OVHClient.Delete(fmt.Sprintf("/cloud/project/snowflake/database/%s", data.ID.ValueString()), nil)
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Snowflake Database",
+			fmt.Sprintf("Could not delete database %s: %s", data.ID.ValueString(), err),
+		)
+	}
+}
+
+func (r *SnowflakeDatabaseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = databaseIdentity.importID(ctx, r.config, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	importAccountObject(ctx, r.config, "database", "/cloud/project/snowflake/database", req, resp)
+}
+
+// MoveState moves databases from the upstream Snowflake provider.
+func (r *SnowflakeDatabaseResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		upstreamStateMover([]string{"snowflake_database"}, func(ctx context.Context, sourceType string, source upstreamState, resp *resource.MoveStateResponse) {
+			data := SnowflakeDatabaseResourceModel{
+				Name:    source.string("name"),
+				Comment: source.string("comment"),
+			}
+
+			resp.Diagnostics.Append(resp.TargetState.Set(ctx, &data)...)
+		}),
+	}
+}
+
+// snowflakeDatabaseResourceModelV0 is the state of the database at version 0.
+type snowflakeDatabaseResourceModelV0 struct {
+	ID      types.String `tfsdk:"id"`
+	Name    types.String `tfsdk:"name"`
+	Comment types.String `tfsdk:"comment"`
+}
+
+// UpgradeState upgrades state from version 0, which stored a placeholder
+// rather than the OVH ID of the database.
+func (r *SnowflakeDatabaseResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":      schema.StringAttribute{Computed: true},
+					"name":    schema.StringAttribute{Required: true},
+					"comment": schema.StringAttribute{Optional: true},
+				},
+			},
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var prior snowflakeDatabaseResourceModelV0
+				resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				data := SnowflakeDatabaseResourceModel{
+					ID:      upgradeStubID(prior.ID, "database-%s", prior.Name),
+					Name:    prior.Name,
+					Comment: prior.Comment,
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			},
+		},
+	}
+}
+
+// read refreshes data from the OVH API after a create or update.
+func (r *SnowflakeDatabaseResource) read(ctx context.Context, data *SnowflakeDatabaseResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var database map[string]interface{}
+	err := r.config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/database/%s", data.ID.ValueString()), &database)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Snowflake Database",
+			fmt.Sprintf("Could not read database %s: %s", data.ID.ValueString(), err),
+		)
+		return diags
+	}
+
+	data.refresh(database)
+	return diags
+}
+
+// refresh copies an OVH API database into the model.
+func (m *SnowflakeDatabaseResourceModel) refresh(database map[string]interface{}) {
+	m.Name = apiString(database, "name")
+	m.Comment = apiOptionalString(database, "comment")
 }
@@ -0,0 +1,598 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/swcstudio/terraform-provider-ovh.snowflake/internal/sdk/identifiers"
+)
+
+var (
+	_ resource.Resource                = &SnowflakeDynamicTableResource{}
+	_ resource.ResourceWithModifyPlan  = &SnowflakeDynamicTableResource{}
+	_ resource.ResourceWithImportState = &SnowflakeDynamicTableResource{}
+	_ resource.ResourceWithIdentity    = &SnowflakeDynamicTableResource{}
+)
+
+var dynamicTableIdentity = schemaObjectIdentity("dynamic table")
+
+func NewSnowflakeDynamicTableResource() resource.Resource {
+	return &SnowflakeDynamicTableResource{}
+}
+
+type SnowflakeDynamicTableResource struct {
+	config *Config
+}
+
+type SnowflakeDynamicTableResourceModel struct {
+	ID                 types.String      `tfsdk:"id"`
+	Name               types.String      `tfsdk:"name"`
+	Database           types.String      `tfsdk:"database"`
+	Schema             types.String      `tfsdk:"schema"`
+	Query              SQLStatementValue `tfsdk:"query"`
+	TargetLag          types.String      `tfsdk:"target_lag"`
+	Warehouse          types.String      `tfsdk:"warehouse"`
+	RefreshMode        types.String      `tfsdk:"refresh_mode"`
+	Initialize         types.String      `tfsdk:"initialize"`
+	Suspended          types.Bool        `tfsdk:"suspended"`
+	Comment            types.String      `tfsdk:"comment"`
+	FullyQualifiedName types.String      `tfsdk:"fully_qualified_name"`
+	SchedulingState    types.String      `tfsdk:"scheduling_state"`
+	RefreshStatus      types.String      `tfsdk:"refresh_status"`
+	DataTimestamp      types.String      `tfsdk:"data_timestamp"`
+	Owner              types.String      `tfsdk:"owner"`
+	CreatedOn          types.String      `tfsdk:"created_on"`
+}
+
+// targetLagPattern matches a Snowflake TARGET_LAG duration such as
+// "5 minutes" or "1 hour".
+var targetLagPattern = regexp.MustCompile(`(?i)^\s*(\d+)\s*(second|seconds|minute|minutes|hour|hours|day|days)\s*$`)
+
+// targetLagValidator accepts DOWNSTREAM or a duration of at least one minute.
+type targetLagValidator struct{}
+
+func (v targetLagValidator) Description(ctx context.Context) string {
+	return "value must be DOWNSTREAM or a duration of at least 1 minute, such as \"5 minutes\""
+}
+
+func (v targetLagValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v targetLagValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if strings.EqualFold(strings.TrimSpace(value), "DOWNSTREAM") {
+		return
+	}
+
+	matches := targetLagPattern.FindStringSubmatch(value)
+	if matches == nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Target Lag",
+			fmt.Sprintf("%q is not a valid target lag: %s.", value, v.Description(ctx)),
+		)
+		return
+	}
+
+	amount, _ := strconv.Atoi(matches[1])
+	if strings.HasPrefix(strings.ToLower(matches[2]), "second") && amount < 60 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Target Lag",
+			fmt.Sprintf("%q is below the minimum target lag of 1 minute.", value),
+		)
+	}
+}
+
+func (r *SnowflakeDynamicTableResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dynamic_table"
+}
+
+func (r *SnowflakeDynamicTableResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Snowflake dynamic table on OVH infrastructure.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Unique identifier for the dynamic table.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the dynamic table.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					identifiers.ValidName(),
+				},
+			},
+			"database": schema.StringAttribute{
+				Description: "Database that contains the dynamic table.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					identifiers.ValidName(),
+				},
+			},
+			"schema": schema.StringAttribute{
+				Description: "Schema that contains the dynamic table.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					identifiers.ValidName(),
+				},
+			},
+			"query": schema.StringAttribute{
+				Description: "Query whose results the dynamic table materializes. Formatting differences are ignored; other changes recreate the table.",
+				Required:    true,
+				CustomType:  SQLStatementType{},
+			},
+			"target_lag": schema.StringAttribute{
+				Description: "Maximum amount of time the content may lag behind its sources, such as \"5 minutes\", or DOWNSTREAM to refresh only when dependent dynamic tables need it.",
+				Required:    true,
+				Validators: []validator.String{
+					targetLagValidator{},
+				},
+			},
+			"warehouse": schema.StringAttribute{
+				Description: "Warehouse that provides compute for refreshes.",
+				Required:    true,
+				Validators: []validator.String{
+					identifiers.ValidName(),
+				},
+			},
+			"refresh_mode": schema.StringAttribute{
+				Description: "Refresh mode (AUTO, FULL or INCREMENTAL). Changing it recreates the table.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("AUTO"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					oneOfValidator{values: []string{"AUTO", "FULL", "INCREMENTAL"}},
+				},
+			},
+			"initialize": schema.StringAttribute{
+				Description: "When the initial refresh happens (ON_CREATE or ON_SCHEDULE). Changing it recreates the table.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("ON_CREATE"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					oneOfValidator{values: []string{"ON_CREATE", "ON_SCHEDULE"}},
+				},
+			},
+			"suspended": schema.BoolAttribute{
+				Description: "Whether scheduled refreshes are suspended. Changing it suspends or resumes the dynamic table in place.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"comment": schema.StringAttribute{
+				Description: "Comment for the dynamic table.",
+				Optional:    true,
+			},
+			"fully_qualified_name": schema.StringAttribute{
+				Description: "Fully qualified name of the dynamic table.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"scheduling_state": schema.StringAttribute{
+				Description: "Scheduling state reported by Snowflake (RUNNING or SUSPENDED).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"refresh_status": schema.StringAttribute{
+				Description: "Status of the most recent refresh.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"data_timestamp": schema.StringAttribute{
+				Description: "Timestamp of the data in the dynamic table as of its last successful refresh.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"owner": schema.StringAttribute{
+				Description: "Role that owns the dynamic table.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_on": schema.StringAttribute{
+				Description: "Creation timestamp of the dynamic table.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SnowflakeDynamicTableResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = dynamicTableIdentity.schema()
+}
+
+// dynamicTableRefreshAttributes are reported by Snowflake and change when the
+// dynamic table is refreshed, suspended or resumed.
+var dynamicTableRefreshAttributes = []string{"scheduling_state", "refresh_status", "data_timestamp"}
+
+// ModifyPlan leaves the refresh attributes unknown when a new query triggers
+// a full refresh or the dynamic table is suspended or resumed. Other updates
+// keep their prior values.
+func (r *SnowflakeDynamicTableResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state SnowflakeDynamicTableResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	queryChanged := plan.Query.IsUnknown() || !sqlEquivalent(plan.Query.ValueString(), state.Query.ValueString())
+	if !queryChanged && plan.Suspended.Equal(state.Suspended) {
+		return
+	}
+	for _, attribute := range dynamicTableRefreshAttributes {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(attribute), types.StringUnknown())...)
+	}
+}
+
+func (r *SnowflakeDynamicTableResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.config = config
+}
+
+func (r *SnowflakeDynamicTableResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SnowflakeDynamicTableResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating Snowflake dynamic table", map[string]interface{}{
+		"name":     data.Name.ValueString(),
+		"database": data.Database.ValueString(),
+		"schema":   data.Schema.ValueString(),
+	})
+
+	dynamicTableConfig := map[string]interface{}{
+		"name":        data.Name.ValueString(),
+		"database":    data.Database.ValueString(),
+		"schema":      data.Schema.ValueString(),
+		"query":       data.Query.ValueString(),
+		"targetLag":   data.TargetLag.ValueString(),
+		"warehouse":   data.Warehouse.ValueString(),
+		"refreshMode": data.RefreshMode.ValueString(),
+		"initialize":  data.Initialize.ValueString(),
+		"comment":     data.Comment.ValueString(),
+	}
+
+	var result map[string]interface{}
+	err := r.config.OVHClient.Post("/cloud/project/snowflake/dynamic-table", dynamicTableConfig, &result)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Snowflake Dynamic Table",
+			fmt.Sprintf("Could not create dynamic table %s: %s", data.Name.ValueString(), err),
+		)
+		return
+	}
+
+	data.ID = apiString(result, "id")
+
+	if data.Suspended.ValueBool() {
+		resp.Diagnostics.Append(r.setSuspended(ctx, data.ID.ValueString(), true)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Created Snowflake dynamic table")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(dynamicTableIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
+}
+
+func (r *SnowflakeDynamicTableResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SnowflakeDynamicTableResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Snowflake dynamic table", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	var dynamicTable map[string]interface{}
+	err := r.config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/dynamic-table/%s", data.ID.ValueString()), &dynamicTable)
+	if isNotFoundError(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Snowflake Dynamic Table",
+			fmt.Sprintf("Could not read dynamic table %s: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	data.refresh(dynamicTable)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(dynamicTableIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
+}
+
+func (r *SnowflakeDynamicTableResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state SnowflakeDynamicTableResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating Snowflake dynamic table", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	updateConfig := map[string]interface{}{}
+
+	// Snowflake cannot ALTER the query of a dynamic table; a changed query is
+	// applied with CREATE OR REPLACE, which triggers a full refresh.
+	if !sqlEquivalent(data.Query.ValueString(), state.Query.ValueString()) {
+		updateConfig["query"] = data.Query.ValueString()
+	}
+	if !data.TargetLag.Equal(state.TargetLag) {
+		updateConfig["targetLag"] = data.TargetLag.ValueString()
+	}
+	if !data.Warehouse.Equal(state.Warehouse) {
+		updateConfig["warehouse"] = data.Warehouse.ValueString()
+	}
+	if !data.Comment.Equal(state.Comment) {
+		updateConfig["comment"] = data.Comment.ValueString()
+	}
+
+	if len(updateConfig) > 0 {
+		err := r.config.OVHClient.Put(fmt.Sprintf("/cloud/project/snowflake/dynamic-table/%s", data.ID.ValueString()), updateConfig, nil)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Snowflake Dynamic Table",
+				fmt.Sprintf("Could not update dynamic table %s: %s", data.ID.ValueString(), err),
+			)
+			return
+		}
+	}
+
+	if !data.Suspended.Equal(state.Suspended) {
+		resp.Diagnostics.Append(r.setSuspended(ctx, data.ID.ValueString(), data.Suspended.ValueBool())...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	planned := data
+	resp.Diagnostics.Append(r.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A scheduled refresh may finish between plan and apply. Keep the refresh
+	// attributes the plan carried over and let the next Read pick up newer ones.
+	if !planned.SchedulingState.IsUnknown() {
+		data.SchedulingState = planned.SchedulingState
+	}
+	if !planned.RefreshStatus.IsUnknown() {
+		data.RefreshStatus = planned.RefreshStatus
+	}
+	if !planned.DataTimestamp.IsUnknown() {
+		data.DataTimestamp = planned.DataTimestamp
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(dynamicTableIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
+}
+
+func (r *SnowflakeDynamicTableResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SnowflakeDynamicTableResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting Snowflake dynamic table", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.config.OVHClient.Delete(fmt.Sprintf("/cloud/project/snowflake/dynamic-table/%s", data.ID.ValueString()), nil)
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Snowflake Dynamic Table",
+			fmt.Sprintf("Could not delete dynamic table %s: %s", data.ID.ValueString(), err),
+		)
+	}
+}
+
+func (r *SnowflakeDynamicTableResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = dynamicTableIdentity.importID(ctx, r.config, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	importSchemaObject(ctx, r.config, "dynamic table", "/cloud/project/snowflake/dynamic-table", req, resp)
+}
+
+// setSuspended suspends or resumes scheduled refreshes of a dynamic table.
+func (r *SnowflakeDynamicTableResource) setSuspended(ctx context.Context, id string, suspended bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	action := "resume"
+	if suspended {
+		action = "suspend"
+	}
+
+	tflog.Debug(ctx, "Changing Snowflake dynamic table scheduling state", map[string]interface{}{
+		"id":     id,
+		"action": action,
+	})
+
+	err := r.config.OVHClient.Post(fmt.Sprintf("/cloud/project/snowflake/dynamic-table/%s/%s", id, action), nil, nil)
+	if err != nil {
+		diags.AddError(
+			"Error Updating Snowflake Dynamic Table",
+			fmt.Sprintf("Could not %s dynamic table %s: %s", action, id, err),
+		)
+	}
+	return diags
+}
+
+// read refreshes data from the OVH API after a create or update.
+func (r *SnowflakeDynamicTableResource) read(ctx context.Context, data *SnowflakeDynamicTableResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var dynamicTable map[string]interface{}
+	err := r.config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/dynamic-table/%s", data.ID.ValueString()), &dynamicTable)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Snowflake Dynamic Table",
+			fmt.Sprintf("Could not read dynamic table %s: %s", data.ID.ValueString(), err),
+		)
+		return diags
+	}
+
+	data.refresh(dynamicTable)
+	return diags
+}
+
+// refresh copies an OVH API dynamic table into the model.
+func (m *SnowflakeDynamicTableResourceModel) refresh(dynamicTable map[string]interface{}) {
+	m.Name = apiString(dynamicTable, "name")
+	m.Database = apiString(dynamicTable, "database")
+	m.Schema = apiString(dynamicTable, "schema")
+	m.Warehouse = apiString(dynamicTable, "warehouse")
+	m.RefreshMode = apiString(dynamicTable, "refreshMode")
+	m.Comment = apiOptionalString(dynamicTable, "comment")
+	m.SchedulingState = apiString(dynamicTable, "schedulingState")
+	m.RefreshStatus = apiOptionalString(dynamicTable, "lastRefreshStatus")
+	m.DataTimestamp = apiOptionalString(dynamicTable, "dataTimestamp")
+	m.Owner = apiString(dynamicTable, "owner")
+	m.CreatedOn = apiString(dynamicTable, "createdOn")
+	m.Suspended = types.BoolValue(strings.EqualFold(m.SchedulingState.ValueString(), "SUSPENDED"))
+	m.FullyQualifiedName = types.StringValue(identifiers.NewSchemaObjectIdentifier(
+		m.Database.ValueString(), m.Schema.ValueString(), m.Name.ValueString()).FullyQualifiedName())
+
+	if text, ok := dynamicTable["text"].(string); ok && text != "" {
+		m.Query = NewSQLStatementValue(viewQueryText(text))
+	}
+
+	// Snowflake reports the lag in its own casing, e.g. "5 minutes" or
+	// "DOWNSTREAM"; keep the configured spelling when they mean the same.
+	if targetLag, ok := dynamicTable["targetLag"].(string); ok && !targetLagEqual(targetLag, m.TargetLag.ValueString()) {
+		m.TargetLag = types.StringValue(targetLag)
+	}
+
+	// INITIALIZE only applies at creation time and is not reported back.
+	if m.Initialize.IsNull() || m.Initialize.IsUnknown() {
+		m.Initialize = types.StringValue("ON_CREATE")
+	}
+}
+
+// targetLagEqual reports whether two target lag values are the same duration.
+// Values that cannot be parsed are only equal when spelled the same.
+func targetLagEqual(a, b string) bool {
+	if a == b {
+		return true
+	}
+
+	secondsA, okA := targetLagSeconds(a)
+	secondsB, okB := targetLagSeconds(b)
+	return okA && okB && secondsA == secondsB
+}
+
+// targetLagSeconds converts a target lag to seconds, with DOWNSTREAM as -1.
+// It reports false when lag cannot be parsed.
+func targetLagSeconds(lag string) (int, bool) {
+	if strings.EqualFold(strings.TrimSpace(lag), "DOWNSTREAM") {
+		return -1, true
+	}
+
+	matches := targetLagPattern.FindStringSubmatch(lag)
+	if matches == nil {
+		return 0, false
+	}
+
+	amount, _ := strconv.Atoi(matches[1])
+	switch unit := strings.ToLower(matches[2]); {
+	case strings.HasPrefix(unit, "minute"):
+		return amount * 60, true
+	case strings.HasPrefix(unit, "hour"):
+		return amount * 3600, true
+	case strings.HasPrefix(unit, "day"):
+		return amount * 86400, true
+	default:
+		return amount, true
+	}
+}
@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestTargetLagValidator(t *testing.T) {
+	testCases := map[string]struct {
+		value       string
+		expectError bool
+	}{
+		"downstream":       {value: "DOWNSTREAM"},
+		"downstream_lower": {value: "downstream"},
+		"minutes":          {value: "5 minutes"},
+		"singular_hour":    {value: "1 hour"},
+		"sixty_seconds":    {value: "60 seconds"},
+		"below_minimum":    {value: "30 seconds", expectError: true},
+		"missing_unit":     {value: "10", expectError: true},
+		"unknown_unit":     {value: "2 weeks", expectError: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:        path.Root("target_lag"),
+				ConfigValue: types.StringValue(tc.value),
+			}
+			resp := &validator.StringResponse{}
+
+			targetLagValidator{}.ValidateString(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tc.expectError {
+				t.Errorf("expected error %t, got diagnostics: %v", tc.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestTargetLagEqual(t *testing.T) {
+	testCases := map[string]struct {
+		a, b     string
+		expected bool
+	}{
+		"same_spelling":    {a: "5 minutes", b: "5 minutes", expected: true},
+		"unit_conversion":  {a: "1 hour", b: "60 minutes", expected: true},
+		"case_and_spacing": {a: "2 HOURS", b: "2hours", expected: true},
+		"downstream":       {a: "DOWNSTREAM", b: "downstream", expected: true},
+		"different":        {a: "5 minutes", b: "10 minutes", expected: false},
+		"downstream_lag":   {a: "DOWNSTREAM", b: "1 minute", expected: false},
+		"both_invalid":     {a: "soon", b: "later", expected: false},
+		"same_invalid":     {a: "soon", b: "soon", expected: true},
+		"one_invalid":      {a: "soon", b: "1 minute", expected: false},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := targetLagEqual(tc.a, tc.b); got != tc.expected {
+				t.Errorf("expected %t for %q and %q, got %t", tc.expected, tc.a, tc.b, got)
+			}
+		})
+	}
+}
+
+func TestSnowflakeDynamicTableResource_ModifyPlanRefreshAttributes(t *testing.T) {
+	state := SnowflakeDynamicTableResourceModel{
+		ID:                 types.StringValue("1"),
+		Name:               types.StringValue("DAILY_ORDERS"),
+		Database:           types.StringValue("ANALYTICS"),
+		Schema:             types.StringValue("PUBLIC"),
+		Query:              NewSQLStatementValue("SELECT * FROM orders"),
+		TargetLag:          types.StringValue("5 minutes"),
+		Warehouse:          types.StringValue("ANALYTICS_WH"),
+		RefreshMode:        types.StringValue("AUTO"),
+		Initialize:         types.StringValue("ON_CREATE"),
+		Suspended:          types.BoolValue(false),
+		Comment:            types.StringNull(),
+		FullyQualifiedName: types.StringValue("ANALYTICS.PUBLIC.DAILY_ORDERS"),
+		SchedulingState:    types.StringValue("RUNNING"),
+		RefreshStatus:      types.StringValue("SUCCEEDED"),
+		DataTimestamp:      types.StringValue("2026-10-19T08:00:00Z"),
+		Owner:              types.StringValue("SYSADMIN"),
+		CreatedOn:          types.StringValue("2026-10-01T08:00:00Z"),
+	}
+
+	testCases := map[string]struct {
+		change      func(*SnowflakeDynamicTableResourceModel)
+		wantUnknown bool
+	}{
+		"comment":   {change: func(m *SnowflakeDynamicTableResourceModel) { m.Comment = types.StringValue("daily") }},
+		"query":     {change: func(m *SnowflakeDynamicTableResourceModel) { m.Query = NewSQLStatementValue("SELECT id FROM orders") }, wantUnknown: true},
+		"suspended": {change: func(m *SnowflakeDynamicTableResourceModel) { m.Suspended = types.BoolValue(true) }, wantUnknown: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			r := &SnowflakeDynamicTableResource{}
+			schemaResp := &fwresource.SchemaResponse{}
+			r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+			null := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)
+
+			plan := state
+			tc.change(&plan)
+			stateValue := tfsdk.State{Schema: schemaResp.Schema, Raw: null}
+			planValue := tfsdk.Plan{Schema: schemaResp.Schema, Raw: null}
+			if diags := stateValue.Set(ctx, &state); diags.HasError() {
+				t.Fatalf("setting state: %v", diags)
+			}
+			if diags := planValue.Set(ctx, &plan); diags.HasError() {
+				t.Fatalf("setting plan: %v", diags)
+			}
+
+			resp := &fwresource.ModifyPlanResponse{Plan: planValue}
+			r.ModifyPlan(ctx, fwresource.ModifyPlanRequest{Plan: planValue, State: stateValue}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			for _, attribute := range dynamicTableRefreshAttributes {
+				var value types.String
+				resp.Plan.GetAttribute(ctx, path.Root(attribute), &value)
+				if value.IsUnknown() != tc.wantUnknown {
+					t.Errorf("%s = %s, want unknown %t", attribute, value, tc.wantUnknown)
+				}
+			}
+		})
+	}
+}
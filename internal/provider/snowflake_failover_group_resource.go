@@ -0,0 +1,290 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = &SnowflakeFailoverGroupResource{}
+	_ resource.ResourceWithValidateConfig = &SnowflakeFailoverGroupResource{}
+	_ resource.ResourceWithModifyPlan     = &SnowflakeFailoverGroupResource{}
+	_ resource.ResourceWithImportState    = &SnowflakeFailoverGroupResource{}
+	_ resource.ResourceWithIdentity       = &SnowflakeFailoverGroupResource{}
+)
+
+var failoverGroupIdentity = accountObjectIdentity("failover group")
+
+func NewSnowflakeFailoverGroupResource() resource.Resource {
+	return &SnowflakeFailoverGroupResource{}
+}
+
+type SnowflakeFailoverGroupResource struct {
+	config *Config
+}
+
+type SnowflakeFailoverGroupResourceModel struct {
+	SnowflakeReplicationGroupResourceModel
+
+	Primary         types.Bool   `tfsdk:"primary"`
+	ConfirmFailover types.String `tfsdk:"confirm_failover"`
+}
+
+func (r *SnowflakeFailoverGroupResource) api() replicationGroupAPI {
+	return replicationGroupAPI{
+		config:   r.config,
+		endpoint: "/cloud/project/snowflake/failover-group",
+		kind:     "failover group",
+		title:    "Failover Group",
+	}
+}
+
+func (r *SnowflakeFailoverGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_failover_group"
+}
+
+func (r *SnowflakeFailoverGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := replicationGroupAttributes("failover group")
+
+	attributes["primary"] = schema.BoolAttribute{
+		Description: "Whether this group should be the primary. Changing it from false to true on a secondary fails over to this account; confirm_failover must be set to the group name for the change to be planned.",
+		Optional:    true,
+		Computed:    true,
+		PlanModifiers: []planmodifier.Bool{
+			boolplanmodifier.UseStateForUnknown(),
+		},
+	}
+	attributes["confirm_failover"] = schema.StringAttribute{
+		Description: "Safety confirmation for promoting this secondary to primary. Must equal the group name when primary changes to true.",
+		Optional:    true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a Snowflake failover group on OVH infrastructure. Secondaries in accounts in other OVH regions can be promoted to primary for disaster recovery.",
+		Attributes:  attributes,
+	}
+}
+
+func (r *SnowflakeFailoverGroupResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = failoverGroupIdentity.schema()
+}
+
+func (r *SnowflakeFailoverGroupResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data SnowflakeFailoverGroupResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.validate(ctx)...)
+
+	if data.ReplicaOf.IsNull() && !data.Primary.IsNull() && !data.Primary.IsUnknown() && !data.Primary.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("primary"),
+			"Invalid Primary Setting",
+			"A group created without replica_of is always the primary.",
+		)
+	}
+}
+
+func (r *SnowflakeFailoverGroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan SnowflakeFailoverGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Primary.IsUnknown() {
+		return
+	}
+
+	if req.State.Raw.IsNull() {
+		if !plan.ReplicaOf.IsNull() && plan.Primary.ValueBool() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("primary"),
+				"Cannot Create Promoted Secondary",
+				"Create the secondary with primary unset or false, then promote it in a separate apply.",
+			)
+		}
+		return
+	}
+
+	var state SnowflakeFailoverGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkFailoverTransition(state.Primary.ValueBool(), plan.Primary.ValueBool(),
+		plan.Name.ValueString(), plan.ConfirmFailover)...)
+}
+
+// checkFailoverTransition validates a change of the primary attribute. A
+// secondary can only be promoted with a matching confirmation; a primary is
+// demoted by promoting one of its secondaries instead.
+func checkFailoverTransition(wasPrimary, wantPrimary bool, name string, confirmation types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch {
+	case !wasPrimary && wantPrimary:
+		if confirmation.IsUnknown() || confirmation.ValueString() != name {
+			diags.AddAttributeError(
+				path.Root("confirm_failover"),
+				"Failover Not Confirmed",
+				fmt.Sprintf("Promoting failover group %s to primary fails over every replicated object to this account. Set confirm_failover = %q to proceed.", name, name),
+			)
+		}
+	case wasPrimary && !wantPrimary:
+		diags.AddAttributeError(
+			path.Root("primary"),
+			"Cannot Demote Primary Failover Group",
+			fmt.Sprintf("Failover group %s cannot be demoted directly. Promote a secondary in another account instead; this group becomes a secondary when that happens.", name),
+		)
+	}
+
+	return diags
+}
+
+func (r *SnowflakeFailoverGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.config = config
+}
+
+func (r *SnowflakeFailoverGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SnowflakeFailoverGroupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.api().create(ctx, &data.SnowflakeReplicationGroupResourceModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Primary = data.IsPrimary
+
+	tflog.Trace(ctx, "Created Snowflake failover group")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(failoverGroupIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
+}
+
+func (r *SnowflakeFailoverGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SnowflakeFailoverGroupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Snowflake failover group", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	group, err := r.api().get(&data.SnowflakeReplicationGroupResourceModel)
+	if isNotFoundError(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Snowflake Failover Group",
+			fmt.Sprintf("Could not read failover group %s: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(data.refresh(ctx, group)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A failover initiated from another account shows up as drift here.
+	data.Primary = data.IsPrimary
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(failoverGroupIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
+}
+
+func (r *SnowflakeFailoverGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state SnowflakeFailoverGroupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Primary.ValueBool() && !state.Primary.ValueBool() {
+		tflog.Info(ctx, "Promoting Snowflake failover group to primary", map[string]interface{}{
+			"id":   data.ID.ValueString(),
+			"name": data.Name.ValueString(),
+		})
+
+		err := r.config.OVHClient.Post(fmt.Sprintf("/cloud/project/snowflake/failover-group/%s/primary", data.ID.ValueString()), nil, nil)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Promoting Snowflake Failover Group",
+				fmt.Sprintf("Could not promote failover group %s to primary: %s", data.Name.ValueString(), err),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(r.api().update(ctx, &data.SnowflakeReplicationGroupResourceModel, &state.SnowflakeReplicationGroupResourceModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.api().read(ctx, &data.SnowflakeReplicationGroupResourceModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Primary = data.IsPrimary
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(failoverGroupIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
+}
+
+func (r *SnowflakeFailoverGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SnowflakeFailoverGroupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.api().delete(ctx, &data.SnowflakeReplicationGroupResourceModel)...)
+}
+
+func (r *SnowflakeFailoverGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = failoverGroupIdentity.importID(ctx, r.config, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	importAccountObject(ctx, r.config, r.api().kind, r.api().endpoint, req, resp)
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCheckFailoverTransition(t *testing.T) {
+	testCases := map[string]struct {
+		wasPrimary, wantPrimary bool
+		confirmation            types.String
+		expectError             bool
+	}{
+		"unchanged_secondary":  {wasPrimary: false, wantPrimary: false, confirmation: types.StringNull()},
+		"unchanged_primary":    {wasPrimary: true, wantPrimary: true, confirmation: types.StringNull()},
+		"promote_confirmed":    {wasPrimary: false, wantPrimary: true, confirmation: types.StringValue("DR_GROUP")},
+		"promote_unconfirmed":  {wasPrimary: false, wantPrimary: true, confirmation: types.StringNull(), expectError: true},
+		"promote_wrong_name":   {wasPrimary: false, wantPrimary: true, confirmation: types.StringValue("dr_group"), expectError: true},
+		"promote_unknown_name": {wasPrimary: false, wantPrimary: true, confirmation: types.StringUnknown(), expectError: true},
+		"demote":               {wasPrimary: true, wantPrimary: false, confirmation: types.StringValue("DR_GROUP"), expectError: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			diags := checkFailoverTransition(tc.wasPrimary, tc.wantPrimary, "DR_GROUP", tc.confirmation)
+			if diags.HasError() != tc.expectError {
+				t.Errorf("expected error %t, got diagnostics: %v", tc.expectError, diags)
+			}
+		})
+	}
+}
+
+func TestReplicationSchedulePattern(t *testing.T) {
+	valid := []string{"10 MINUTE", "1 minutes", "USING CRON 0 * * * * UTC", "using cron */15 8-18 * * MON-FRI Europe/Paris"}
+	invalid := []string{"10", "every hour", "USING CRON 0 * * * UTC", "10 HOUR"}
+
+	for _, schedule := range valid {
+		if !replicationSchedulePattern.MatchString(schedule) {
+			t.Errorf("expected %q to be valid", schedule)
+		}
+	}
+	for _, schedule := range invalid {
+		if replicationSchedulePattern.MatchString(schedule) {
+			t.Errorf("expected %q to be invalid", schedule)
+		}
+	}
+}
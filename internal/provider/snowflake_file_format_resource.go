@@ -0,0 +1,748 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/swcstudio/terraform-provider-ovh.snowflake/internal/sdk/identifiers"
+)
+
+var (
+	_ resource.Resource                   = &SnowflakeFileFormatResource{}
+	_ resource.ResourceWithValidateConfig = &SnowflakeFileFormatResource{}
+	_ resource.ResourceWithModifyPlan     = &SnowflakeFileFormatResource{}
+	_ resource.ResourceWithImportState    = &SnowflakeFileFormatResource{}
+	_ resource.ResourceWithIdentity       = &SnowflakeFileFormatResource{}
+)
+
+var fileFormatIdentity = schemaObjectIdentity("file format")
+
+func NewSnowflakeFileFormatResource() resource.Resource {
+	return &SnowflakeFileFormatResource{}
+}
+
+type SnowflakeFileFormatResource struct {
+	config *Config
+}
+
+type SnowflakeFileFormatResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	Database           types.String `tfsdk:"database"`
+	Schema             types.String `tfsdk:"schema"`
+	Comment            types.String `tfsdk:"comment"`
+	FormatType         types.String `tfsdk:"format_type"`
+	FullyQualifiedName types.String `tfsdk:"fully_qualified_name"`
+	CSV                types.Object `tfsdk:"csv"`
+	JSON               types.Object `tfsdk:"json"`
+	Parquet            types.Object `tfsdk:"parquet"`
+	Avro               types.Object `tfsdk:"avro"`
+	ORC                types.Object `tfsdk:"orc"`
+	XML                types.Object `tfsdk:"xml"`
+}
+
+type fileFormatOptionKind int
+
+const (
+	fileFormatOptionString fileFormatOptionKind = iota
+	fileFormatOptionBool
+	fileFormatOptionInt
+	fileFormatOptionList
+)
+
+// fileFormatOption describes a single format type option, how it is named in
+// the OVH API and which values Snowflake accepts for it.
+type fileFormatOption struct {
+	Name          string
+	Key           string
+	Kind          fileFormatOptionKind
+	Description   string
+	AllowedValues []string
+}
+
+// fileFormatTypes lists the supported format types in the order their option
+// blocks appear in the schema. Each block is named after the lower-cased type.
+var fileFormatTypes = []string{"CSV", "JSON", "PARQUET", "AVRO", "ORC", "XML"}
+
+var (
+	fileFormatCompressionGeneric = []string{"AUTO", "GZIP", "BZ2", "BROTLI", "ZSTD", "DEFLATE", "RAW_DEFLATE", "NONE"}
+	fileFormatCompressionAvro    = []string{"AUTO", "GZIP", "BROTLI", "ZSTD", "DEFLATE", "RAW_DEFLATE", "NONE"}
+	fileFormatCompressionParquet = []string{"AUTO", "LZO", "SNAPPY", "NONE"}
+	fileFormatBinaryFormats      = []string{"HEX", "BASE64", "UTF8"}
+)
+
+var fileFormatOptions = map[string][]fileFormatOption{
+	"CSV": {
+		{Name: "compression", Key: "compression", Kind: fileFormatOptionString, Description: "Compression algorithm of the data files.", AllowedValues: fileFormatCompressionGeneric},
+		{Name: "record_delimiter", Key: "recordDelimiter", Kind: fileFormatOptionString, Description: "Characters that separate records in an input file."},
+		{Name: "field_delimiter", Key: "fieldDelimiter", Kind: fileFormatOptionString, Description: "Characters that separate fields in an input file."},
+		{Name: "file_extension", Key: "fileExtension", Kind: fileFormatOptionString, Description: "Extension for files unloaded to a stage."},
+		{Name: "parse_header", Key: "parseHeader", Kind: fileFormatOptionBool, Description: "Whether to use the first row headers to determine column names."},
+		{Name: "skip_header", Key: "skipHeader", Kind: fileFormatOptionInt, Description: "Number of lines at the start of the file to skip."},
+		{Name: "skip_blank_lines", Key: "skipBlankLines", Kind: fileFormatOptionBool, Description: "Whether to skip blank lines encountered in the data files."},
+		{Name: "date_format", Key: "dateFormat", Kind: fileFormatOptionString, Description: "Format of date values in the data files."},
+		{Name: "time_format", Key: "timeFormat", Kind: fileFormatOptionString, Description: "Format of time values in the data files."},
+		{Name: "timestamp_format", Key: "timestampFormat", Kind: fileFormatOptionString, Description: "Format of timestamp values in the data files."},
+		{Name: "binary_format", Key: "binaryFormat", Kind: fileFormatOptionString, Description: "Encoding format for binary input or output.", AllowedValues: fileFormatBinaryFormats},
+		{Name: "escape", Key: "escape", Kind: fileFormatOptionString, Description: "Escape character for enclosed field values."},
+		{Name: "escape_unenclosed_field", Key: "escapeUnenclosedField", Kind: fileFormatOptionString, Description: "Escape character for unenclosed field values."},
+		{Name: "trim_space", Key: "trimSpace", Kind: fileFormatOptionBool, Description: "Whether to remove white space from fields."},
+		{Name: "field_optionally_enclosed_by", Key: "fieldOptionallyEnclosedBy", Kind: fileFormatOptionString, Description: "Character used to enclose strings."},
+		{Name: "null_if", Key: "nullIf", Kind: fileFormatOptionList, Description: "Strings to convert to and from SQL NULL."},
+		{Name: "error_on_column_count_mismatch", Key: "errorOnColumnCountMismatch", Kind: fileFormatOptionBool, Description: "Whether to fail when the number of columns does not match the target table."},
+		{Name: "empty_field_as_null", Key: "emptyFieldAsNull", Kind: fileFormatOptionBool, Description: "Whether to insert SQL NULL for empty fields."},
+		{Name: "encoding", Key: "encoding", Kind: fileFormatOptionString, Description: "Character set of the source data."},
+	},
+	"JSON": {
+		{Name: "compression", Key: "compression", Kind: fileFormatOptionString, Description: "Compression algorithm of the data files.", AllowedValues: fileFormatCompressionGeneric},
+		{Name: "date_format", Key: "dateFormat", Kind: fileFormatOptionString, Description: "Format of date values in the data files."},
+		{Name: "time_format", Key: "timeFormat", Kind: fileFormatOptionString, Description: "Format of time values in the data files."},
+		{Name: "timestamp_format", Key: "timestampFormat", Kind: fileFormatOptionString, Description: "Format of timestamp values in the data files."},
+		{Name: "binary_format", Key: "binaryFormat", Kind: fileFormatOptionString, Description: "Encoding format for binary input or output.", AllowedValues: fileFormatBinaryFormats},
+		{Name: "trim_space", Key: "trimSpace", Kind: fileFormatOptionBool, Description: "Whether to remove white space from fields."},
+		{Name: "null_if", Key: "nullIf", Kind: fileFormatOptionList, Description: "Strings to convert to and from SQL NULL."},
+		{Name: "file_extension", Key: "fileExtension", Kind: fileFormatOptionString, Description: "Extension for files unloaded to a stage."},
+		{Name: "enable_octal", Key: "enableOctal", Kind: fileFormatOptionBool, Description: "Whether to parse octal numbers."},
+		{Name: "allow_duplicate", Key: "allowDuplicate", Kind: fileFormatOptionBool, Description: "Whether to allow duplicate object field names."},
+		{Name: "strip_outer_array", Key: "stripOuterArray", Kind: fileFormatOptionBool, Description: "Whether to remove the outer brackets of the JSON document."},
+		{Name: "strip_null_values", Key: "stripNullValues", Kind: fileFormatOptionBool, Description: "Whether to remove object fields or array elements containing null values."},
+		{Name: "ignore_utf8_errors", Key: "ignoreUtf8Errors", Kind: fileFormatOptionBool, Description: "Whether to replace invalid UTF-8 sequences with the Unicode replacement character."},
+	},
+	"PARQUET": {
+		{Name: "compression", Key: "compression", Kind: fileFormatOptionString, Description: "Compression algorithm of the data files.", AllowedValues: fileFormatCompressionParquet},
+		{Name: "binary_as_text", Key: "binaryAsText", Kind: fileFormatOptionBool, Description: "Whether to interpret columns with no defined logical data type as UTF-8 text."},
+		{Name: "use_logical_type", Key: "useLogicalType", Kind: fileFormatOptionBool, Description: "Whether to use Parquet logical types."},
+		{Name: "trim_space", Key: "trimSpace", Kind: fileFormatOptionBool, Description: "Whether to remove white space from fields."},
+		{Name: "null_if", Key: "nullIf", Kind: fileFormatOptionList, Description: "Strings to convert to and from SQL NULL."},
+	},
+	"AVRO": {
+		{Name: "compression", Key: "compression", Kind: fileFormatOptionString, Description: "Compression algorithm of the data files.", AllowedValues: fileFormatCompressionAvro},
+		{Name: "trim_space", Key: "trimSpace", Kind: fileFormatOptionBool, Description: "Whether to remove white space from fields."},
+		{Name: "null_if", Key: "nullIf", Kind: fileFormatOptionList, Description: "Strings to convert to and from SQL NULL."},
+	},
+	"ORC": {
+		{Name: "trim_space", Key: "trimSpace", Kind: fileFormatOptionBool, Description: "Whether to remove white space from fields."},
+		{Name: "null_if", Key: "nullIf", Kind: fileFormatOptionList, Description: "Strings to convert to and from SQL NULL."},
+	},
+	"XML": {
+		{Name: "compression", Key: "compression", Kind: fileFormatOptionString, Description: "Compression algorithm of the data files.", AllowedValues: fileFormatCompressionGeneric},
+		{Name: "ignore_utf8_errors", Key: "ignoreUtf8Errors", Kind: fileFormatOptionBool, Description: "Whether to replace invalid UTF-8 sequences with the Unicode replacement character."},
+		{Name: "preserve_space", Key: "preserveSpace", Kind: fileFormatOptionBool, Description: "Whether to preserve leading and trailing spaces in element content."},
+		{Name: "strip_outer_element", Key: "stripOuterElement", Kind: fileFormatOptionBool, Description: "Whether to strip out the outer XML element."},
+		{Name: "disable_snowflake_data", Key: "disableSnowflakeData", Kind: fileFormatOptionBool, Description: "Whether to disable recognition of Snowflake semi-structured data tags."},
+		{Name: "disable_auto_convert", Key: "disableAutoConvert", Kind: fileFormatOptionBool, Description: "Whether to disable automatic conversion of numeric and Boolean values."},
+		{Name: "skip_byte_order_mark", Key: "skipByteOrderMark", Kind: fileFormatOptionBool, Description: "Whether to skip the BOM at the start of the data files."},
+	},
+}
+
+func (r *SnowflakeFileFormatResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_file_format"
+}
+
+func (r *SnowflakeFileFormatResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "Unique identifier for the file format.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Description: "Name of the file format.",
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				identifiers.ValidName(),
+			},
+		},
+		"database": schema.StringAttribute{
+			Description: "Database that contains the file format.",
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				identifiers.ValidName(),
+			},
+		},
+		"schema": schema.StringAttribute{
+			Description: "Schema that contains the file format.",
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				identifiers.ValidName(),
+			},
+		},
+		"comment": schema.StringAttribute{
+			Description: "Comment for the file format.",
+			Optional:    true,
+		},
+		"format_type": schema.StringAttribute{
+			Description: "Format type, derived from the option block that is set (CSV, JSON, PARQUET, AVRO, ORC or XML).",
+			Computed:    true,
+		},
+		"fully_qualified_name": schema.StringAttribute{
+			Description: "Fully qualified name of the file format, suitable for FORMAT_NAME references in external tables and pipes.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+
+	for _, formatType := range fileFormatTypes {
+		attributes[strings.ToLower(formatType)] = schema.SingleNestedAttribute{
+			Description: fmt.Sprintf("%s format options. Exactly one format block must be set.", formatType),
+			Optional:    true,
+			Attributes:  fileFormatOptionAttributes(fileFormatOptions[formatType]),
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a Snowflake file format on OVH infrastructure.",
+		Attributes:  attributes,
+	}
+}
+
+func (r *SnowflakeFileFormatResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = fileFormatIdentity.schema()
+}
+
+// fileFormatOptionAttributes builds the nested schema for a format block.
+// Options are not computed: refresh leaves unconfigured options null rather
+// than filling in the Snowflake defaults, so that removing an option from the
+// configuration plans a change that unsets it.
+func fileFormatOptionAttributes(options []fileFormatOption) map[string]schema.Attribute {
+	attributes := make(map[string]schema.Attribute, len(options))
+	for _, option := range options {
+		description := option.Description
+		if len(option.AllowedValues) > 0 {
+			description = fmt.Sprintf("%s Valid values: %s.", description, strings.Join(option.AllowedValues, ", "))
+		}
+
+		switch option.Kind {
+		case fileFormatOptionString:
+			attributes[option.Name] = schema.StringAttribute{
+				Description: description,
+				Optional:    true,
+			}
+		case fileFormatOptionBool:
+			attributes[option.Name] = schema.BoolAttribute{
+				Description: description,
+				Optional:    true,
+			}
+		case fileFormatOptionInt:
+			attributes[option.Name] = schema.Int64Attribute{
+				Description: description,
+				Optional:    true,
+			}
+		case fileFormatOptionList:
+			attributes[option.Name] = schema.ListAttribute{
+				Description: description,
+				ElementType: types.StringType,
+				Optional:    true,
+			}
+		}
+	}
+	return attributes
+}
+
+// fileFormatOptionAttrTypes returns the object attribute types for a format block.
+func fileFormatOptionAttrTypes(options []fileFormatOption) map[string]attr.Type {
+	attrTypes := make(map[string]attr.Type, len(options))
+	for _, option := range options {
+		switch option.Kind {
+		case fileFormatOptionString:
+			attrTypes[option.Name] = types.StringType
+		case fileFormatOptionBool:
+			attrTypes[option.Name] = types.BoolType
+		case fileFormatOptionInt:
+			attrTypes[option.Name] = types.Int64Type
+		case fileFormatOptionList:
+			attrTypes[option.Name] = types.ListType{ElemType: types.StringType}
+		}
+	}
+	return attrTypes
+}
+
+// formatBlocks returns the option block of every format type keyed by type.
+func (m *SnowflakeFileFormatResourceModel) formatBlocks() map[string]*types.Object {
+	return map[string]*types.Object{
+		"CSV":     &m.CSV,
+		"JSON":    &m.JSON,
+		"PARQUET": &m.Parquet,
+		"AVRO":    &m.Avro,
+		"ORC":     &m.ORC,
+		"XML":     &m.XML,
+	}
+}
+
+// selectedFormat returns the format type whose block is set. It returns an
+// empty string when no block is set or when the configuration is not yet known.
+func (m *SnowflakeFileFormatResourceModel) selectedFormat() string {
+	blocks := m.formatBlocks()
+	for _, formatType := range fileFormatTypes {
+		if !blocks[formatType].IsNull() && !blocks[formatType].IsUnknown() {
+			return formatType
+		}
+	}
+	return ""
+}
+
+// expandFileFormatOptions converts a format block into the OVH API payload,
+// omitting options that are not configured.
+func expandFileFormatOptions(block types.Object, options []fileFormatOption) map[string]interface{} {
+	payload := map[string]interface{}{}
+	if block.IsNull() || block.IsUnknown() {
+		return payload
+	}
+
+	values := block.Attributes()
+	for _, option := range options {
+		value, ok := values[option.Name]
+		if !ok || value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		switch v := value.(type) {
+		case types.String:
+			payload[option.Key] = v.ValueString()
+		case types.Bool:
+			payload[option.Key] = v.ValueBool()
+		case types.Int64:
+			payload[option.Key] = v.ValueInt64()
+		case types.List:
+			items := make([]string, 0, len(v.Elements()))
+			for _, element := range v.Elements() {
+				if s, ok := element.(types.String); ok && !s.IsNull() && !s.IsUnknown() {
+					items = append(items, s.ValueString())
+				}
+			}
+			payload[option.Key] = items
+		}
+	}
+	return payload
+}
+
+// unsetFileFormatOptions adds the options set in the prior block but no longer
+// in block to payload as nulls, which the OVH API resets to their defaults.
+func unsetFileFormatOptions(payload map[string]interface{}, block, prior types.Object, options []fileFormatOption) {
+	if prior.IsNull() || prior.IsUnknown() {
+		return
+	}
+
+	priorValues := prior.Attributes()
+	for _, option := range options {
+		if value, ok := priorValues[option.Name]; !ok || value.IsNull() {
+			continue
+		}
+		if _, ok := payload[option.Key]; !ok {
+			payload[option.Key] = nil
+		}
+	}
+}
+
+// flattenFileFormatOptions converts the OVH API options of a file format into
+// a format block value. Options that are null in the prior block stay null,
+// unless the prior block itself is null as after an import.
+func flattenFileFormatOptions(ctx context.Context, apiOptions map[string]interface{}, options []fileFormatOption, prior types.Object) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var priorValues map[string]attr.Value
+	if !prior.IsNull() && !prior.IsUnknown() {
+		priorValues = prior.Attributes()
+	}
+
+	values := make(map[string]attr.Value, len(options))
+	for _, option := range options {
+		if value, ok := priorValues[option.Name]; priorValues != nil && (!ok || value.IsNull()) {
+			values[option.Name] = nullFileFormatOption(option)
+			continue
+		}
+
+		switch option.Kind {
+		case fileFormatOptionString:
+			values[option.Name] = apiString(apiOptions, option.Key)
+		case fileFormatOptionBool:
+			values[option.Name] = apiBool(apiOptions, option.Key)
+		case fileFormatOptionInt:
+			values[option.Name] = apiInt64(apiOptions, option.Key)
+		case fileFormatOptionList:
+			items := apiStringList(apiOptions, option.Key)
+			if items == nil {
+				values[option.Name] = types.ListNull(types.StringType)
+				continue
+			}
+			list, d := types.ListValueFrom(ctx, types.StringType, items)
+			diags.Append(d...)
+			values[option.Name] = list
+		}
+	}
+
+	block, d := types.ObjectValue(fileFormatOptionAttrTypes(options), values)
+	diags.Append(d...)
+	return block, diags
+}
+
+// nullFileFormatOption returns the null value of an option.
+func nullFileFormatOption(option fileFormatOption) attr.Value {
+	switch option.Kind {
+	case fileFormatOptionBool:
+		return types.BoolNull()
+	case fileFormatOptionInt:
+		return types.Int64Null()
+	case fileFormatOptionList:
+		return types.ListNull(types.StringType)
+	default:
+		return types.StringNull()
+	}
+}
+
+// validateFileFormatOptions checks option values against the rules of the
+// given format type.
+func validateFileFormatOptions(formatType string, block types.Object) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	blockPath := path.Root(strings.ToLower(formatType))
+	values := block.Attributes()
+	for _, option := range fileFormatOptions[formatType] {
+		value, ok := values[option.Name]
+		if !ok || value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		switch v := value.(type) {
+		case types.String:
+			if len(option.AllowedValues) == 0 {
+				continue
+			}
+			if !stringInSlice(strings.ToUpper(v.ValueString()), option.AllowedValues) {
+				diags.AddAttributeError(
+					blockPath.AtName(option.Name),
+					"Invalid File Format Option",
+					fmt.Sprintf("%s is not a valid %s value for %s file formats. Valid values: %s.",
+						v.ValueString(), option.Name, formatType, strings.Join(option.AllowedValues, ", ")),
+				)
+			}
+		case types.Int64:
+			if v.ValueInt64() < 0 {
+				diags.AddAttributeError(
+					blockPath.AtName(option.Name),
+					"Invalid File Format Option",
+					fmt.Sprintf("%s must not be negative.", option.Name),
+				)
+			}
+		}
+	}
+
+	if formatType == "CSV" {
+		parseHeader, _ := values["parse_header"].(types.Bool)
+		skipHeader, _ := values["skip_header"].(types.Int64)
+		if parseHeader.ValueBool() && skipHeader.ValueInt64() > 0 {
+			diags.AddAttributeError(
+				blockPath.AtName("skip_header"),
+				"Invalid File Format Option",
+				"skip_header cannot be used together with parse_header = true.",
+			)
+		}
+	}
+
+	return diags
+}
+
+func (r *SnowflakeFileFormatResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data SnowflakeFileFormatResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var configured []string
+	for _, formatType := range fileFormatTypes {
+		block := *data.formatBlocks()[formatType]
+		if block.IsUnknown() {
+			return
+		}
+		if block.IsNull() {
+			continue
+		}
+		configured = append(configured, formatType)
+		resp.Diagnostics.Append(validateFileFormatOptions(formatType, block)...)
+	}
+
+	if len(configured) != 1 {
+		resp.Diagnostics.AddError(
+			"Invalid File Format Configuration",
+			fmt.Sprintf("Exactly one of the csv, json, parquet, avro, orc or xml blocks must be set, got %d.", len(configured)),
+		)
+	}
+}
+
+func (r *SnowflakeFileFormatResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan SnowflakeFileFormatResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	formatType := plan.selectedFormat()
+	if formatType == "" {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("format_type"), formatType)...)
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state SnowflakeFileFormatResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Snowflake cannot change the TYPE of an existing file format without
+	// resetting every format type option, so switching blocks replaces it.
+	if state.FormatType.ValueString() != formatType {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("format_type"))
+	}
+}
+
+func (r *SnowflakeFileFormatResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.config = config
+}
+
+func (r *SnowflakeFileFormatResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SnowflakeFileFormatResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	formatType := data.selectedFormat()
+
+	tflog.Debug(ctx, "Creating Snowflake file format", map[string]interface{}{
+		"name":        data.Name.ValueString(),
+		"database":    data.Database.ValueString(),
+		"schema":      data.Schema.ValueString(),
+		"format_type": formatType,
+	})
+
+	fileFormatConfig := map[string]interface{}{
+		"name":     data.Name.ValueString(),
+		"database": data.Database.ValueString(),
+		"schema":   data.Schema.ValueString(),
+		"type":     formatType,
+		"options":  expandFileFormatOptions(*data.formatBlocks()[formatType], fileFormatOptions[formatType]),
+		"comment":  data.Comment.ValueString(),
+	}
+
+	var result map[string]interface{}
+	err := r.config.OVHClient.Post("/cloud/project/snowflake/file-format", fileFormatConfig, &result)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Snowflake File Format",
+			fmt.Sprintf("Could not create file format %s: %s", data.Name.ValueString(), err),
+		)
+		return
+	}
+
+	data.ID = apiString(result, "id")
+
+	resp.Diagnostics.Append(r.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Created Snowflake file format")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(fileFormatIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
+}
+
+func (r *SnowflakeFileFormatResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SnowflakeFileFormatResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Snowflake file format", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	var fileFormat map[string]interface{}
+	err := r.config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/file-format/%s", data.ID.ValueString()), &fileFormat)
+	if isNotFoundError(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Snowflake File Format",
+			fmt.Sprintf("Could not read file format %s: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(data.refresh(ctx, fileFormat)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(fileFormatIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
+}
+
+func (r *SnowflakeFileFormatResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state SnowflakeFileFormatResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	formatType := data.selectedFormat()
+
+	tflog.Debug(ctx, "Updating Snowflake file format", map[string]interface{}{
+		"id":          data.ID.ValueString(),
+		"format_type": formatType,
+	})
+
+	options := expandFileFormatOptions(*data.formatBlocks()[formatType], fileFormatOptions[formatType])
+	unsetFileFormatOptions(options, *data.formatBlocks()[formatType], *state.formatBlocks()[formatType], fileFormatOptions[formatType])
+
+	updateConfig := map[string]interface{}{
+		"options": options,
+		"comment": data.Comment.ValueString(),
+	}
+
+	err := r.config.OVHClient.Put(fmt.Sprintf("/cloud/project/snowflake/file-format/%s", data.ID.ValueString()), updateConfig, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Snowflake File Format",
+			fmt.Sprintf("Could not update file format %s: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(fileFormatIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
+}
+
+func (r *SnowflakeFileFormatResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SnowflakeFileFormatResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting Snowflake file format", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.config.OVHClient.Delete(fmt.Sprintf("/cloud/project/snowflake/file-format/%s", data.ID.ValueString()), nil)
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Snowflake File Format",
+			fmt.Sprintf("Could not delete file format %s: %s", data.ID.ValueString(), err),
+		)
+	}
+}
+
+func (r *SnowflakeFileFormatResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = fileFormatIdentity.importID(ctx, r.config, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	importSchemaObject(ctx, r.config, "file format", "/cloud/project/snowflake/file-format", req, resp)
+}
+
+// read refreshes data from the OVH API after a create or update.
+func (r *SnowflakeFileFormatResource) read(ctx context.Context, data *SnowflakeFileFormatResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var fileFormat map[string]interface{}
+	err := r.config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/file-format/%s", data.ID.ValueString()), &fileFormat)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Snowflake File Format",
+			fmt.Sprintf("Could not read file format %s: %s", data.ID.ValueString(), err),
+		)
+		return diags
+	}
+
+	return data.refresh(ctx, fileFormat)
+}
+
+// refresh copies an OVH API file format into the model.
+func (m *SnowflakeFileFormatResourceModel) refresh(ctx context.Context, fileFormat map[string]interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.Name = apiString(fileFormat, "name")
+	m.Database = apiString(fileFormat, "database")
+	m.Schema = apiString(fileFormat, "schema")
+	m.Comment = apiOptionalString(fileFormat, "comment")
+	m.FormatType = apiString(fileFormat, "type")
+	m.FullyQualifiedName = types.StringValue(identifiers.NewSchemaObjectIdentifier(
+		m.Database.ValueString(), m.Schema.ValueString(), m.Name.ValueString()).FullyQualifiedName())
+
+	apiOptions, _ := fileFormat["options"].(map[string]interface{})
+	formatType := strings.ToUpper(m.FormatType.ValueString())
+	for blockType, block := range m.formatBlocks() {
+		if blockType != formatType {
+			*block = types.ObjectNull(fileFormatOptionAttrTypes(fileFormatOptions[blockType]))
+			continue
+		}
+		value, d := flattenFileFormatOptions(ctx, apiOptions, fileFormatOptions[blockType], *block)
+		diags.Append(d...)
+		*block = value
+	}
+
+	return diags
+}
@@ -0,0 +1,236 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func testFileFormatBlock(t *testing.T, formatType string, values map[string]attr.Value) types.Object {
+	t.Helper()
+
+	options := fileFormatOptions[formatType]
+	attrTypes := fileFormatOptionAttrTypes(options)
+	for name, attrType := range attrTypes {
+		if _, ok := values[name]; ok {
+			continue
+		}
+		switch attrType {
+		case types.StringType:
+			values[name] = types.StringNull()
+		case types.BoolType:
+			values[name] = types.BoolNull()
+		case types.Int64Type:
+			values[name] = types.Int64Null()
+		default:
+			values[name] = types.ListNull(types.StringType)
+		}
+	}
+
+	block, diags := types.ObjectValue(attrTypes, values)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics building %s block: %v", formatType, diags)
+	}
+	return block
+}
+
+func TestSnowflakeFileFormat_ValidateCompression(t *testing.T) {
+	testCases := []struct {
+		formatType  string
+		compression string
+		valid       bool
+	}{
+		{"CSV", "GZIP", true},
+		{"CSV", "gzip", true},
+		{"CSV", "SNAPPY", false},
+		{"PARQUET", "SNAPPY", true},
+		{"PARQUET", "GZIP", false},
+		{"AVRO", "BZ2", false},
+		{"XML", "ZSTD", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("%s_%s", tc.formatType, tc.compression), func(t *testing.T) {
+			block := testFileFormatBlock(t, tc.formatType, map[string]attr.Value{
+				"compression": types.StringValue(tc.compression),
+			})
+
+			diags := validateFileFormatOptions(tc.formatType, block)
+			if diags.HasError() == tc.valid {
+				t.Errorf("compression %s for %s: expected valid=%t, got diagnostics %v", tc.compression, tc.formatType, tc.valid, diags)
+			}
+		})
+	}
+}
+
+func TestSnowflakeFileFormat_ValidateParseHeaderWithSkipHeader(t *testing.T) {
+	block := testFileFormatBlock(t, "CSV", map[string]attr.Value{
+		"parse_header": types.BoolValue(true),
+		"skip_header":  types.Int64Value(1),
+	})
+
+	if diags := validateFileFormatOptions("CSV", block); !diags.HasError() {
+		t.Error("parse_header combined with skip_header should be rejected")
+	}
+}
+
+func TestSnowflakeFileFormat_ExpandFlattenRoundTrip(t *testing.T) {
+	ctx := context.Background()
+
+	nullIf, diags := types.ListValueFrom(ctx, types.StringType, []string{"", "NULL"})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	block := testFileFormatBlock(t, "CSV", map[string]attr.Value{
+		"field_delimiter": types.StringValue("|"),
+		"skip_header":     types.Int64Value(1),
+		"trim_space":      types.BoolValue(true),
+		"null_if":         nullIf,
+	})
+
+	payload := expandFileFormatOptions(block, fileFormatOptions["CSV"])
+	if len(payload) != 4 {
+		t.Fatalf("expected 4 configured options in payload, got %d: %v", len(payload), payload)
+	}
+
+	// Simulate the OVH client decoding the payload back with UseNumber.
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatalf("unexpected error marshalling payload: %s", err)
+	}
+	var decoded map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	if err := decoder.Decode(&decoded); err != nil {
+		t.Fatalf("unexpected error decoding payload: %s", err)
+	}
+
+	flattened, diags := flattenFileFormatOptions(ctx, decoded, fileFormatOptions["CSV"], types.ObjectNull(fileFormatOptionAttrTypes(fileFormatOptions["CSV"])))
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !flattened.Equal(block) {
+		t.Errorf("round trip mismatch:\nexpected: %s\ngot:      %s", block, flattened)
+	}
+}
+
+func TestSnowflakeFileFormat_FlattenKeepsUnconfiguredOptionsNull(t *testing.T) {
+	ctx := context.Background()
+	apiOptions := map[string]interface{}{
+		"fieldDelimiter": "|",
+		"compression":    "AUTO",
+		"trimSpace":      false,
+	}
+
+	prior := testFileFormatBlock(t, "CSV", map[string]attr.Value{
+		"field_delimiter": types.StringValue(","),
+	})
+	flattened, diags := flattenFileFormatOptions(ctx, apiOptions, fileFormatOptions["CSV"], prior)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	want := testFileFormatBlock(t, "CSV", map[string]attr.Value{
+		"field_delimiter": types.StringValue("|"),
+	})
+	if !flattened.Equal(want) {
+		t.Errorf("expected Snowflake defaults to stay out of state:\nexpected: %s\ngot:      %s", want, flattened)
+	}
+
+	// Without a prior block, as after an import, every option is read.
+	flattened, diags = flattenFileFormatOptions(ctx, apiOptions, fileFormatOptions["CSV"], types.ObjectNull(fileFormatOptionAttrTypes(fileFormatOptions["CSV"])))
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	want = testFileFormatBlock(t, "CSV", map[string]attr.Value{
+		"field_delimiter": types.StringValue("|"),
+		"compression":     types.StringValue("AUTO"),
+		"trim_space":      types.BoolValue(false),
+	})
+	if !flattened.Equal(want) {
+		t.Errorf("expected every option after an import:\nexpected: %s\ngot:      %s", want, flattened)
+	}
+}
+
+func TestSnowflakeFileFormatResource_UpdateUnsetsRemovedOptions(t *testing.T) {
+	const update = "PUT /cloud/project/snowflake/file-format/1"
+	ctx := context.Background()
+
+	api, config := newFakeOVHAPI(t, map[string]interface{}{
+		update: nil,
+		"GET /cloud/project/snowflake/file-format/1": map[string]interface{}{
+			"id": "1", "name": "CSV_FORMAT", "database": "ANALYTICS", "schema": "PUBLIC", "type": "CSV",
+			"options": map[string]interface{}{"fieldDelimiter": ",", "skipHeader": 0, "trimSpace": true},
+		},
+	})
+
+	model := func(csv types.Object) SnowflakeFileFormatResourceModel {
+		m := SnowflakeFileFormatResourceModel{
+			ID:                 types.StringValue("1"),
+			Name:               types.StringValue("CSV_FORMAT"),
+			Database:           types.StringValue("ANALYTICS"),
+			Schema:             types.StringValue("PUBLIC"),
+			Comment:            types.StringNull(),
+			FormatType:         types.StringValue("CSV"),
+			FullyQualifiedName: types.StringValue(`"ANALYTICS"."PUBLIC"."CSV_FORMAT"`),
+		}
+		for formatType, block := range m.formatBlocks() {
+			*block = types.ObjectNull(fileFormatOptionAttrTypes(fileFormatOptions[formatType]))
+		}
+		m.CSV = csv
+		return m
+	}
+	state := model(testFileFormatBlock(t, "CSV", map[string]attr.Value{
+		"field_delimiter": types.StringValue("|"),
+		"skip_header":     types.Int64Value(1),
+		"trim_space":      types.BoolValue(true),
+	}))
+	plan := model(testFileFormatBlock(t, "CSV", map[string]attr.Value{
+		"field_delimiter": types.StringValue(","),
+		"trim_space":      types.BoolValue(true),
+	}))
+
+	r := &SnowflakeFileFormatResource{config: config}
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	null := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)
+
+	req := fwresource.UpdateRequest{
+		Plan:  tfsdk.Plan{Schema: schemaResp.Schema, Raw: null},
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: null},
+	}
+	if diags := req.Plan.Set(ctx, &plan); diags.HasError() {
+		t.Fatalf("setting plan: %v", diags)
+	}
+	if diags := req.State.Set(ctx, &state); diags.HasError() {
+		t.Fatalf("setting state: %v", diags)
+	}
+	resp := &fwresource.UpdateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: null}}
+	r.Update(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("update failed: %v", resp.Diagnostics)
+	}
+
+	options, _ := api.body(update)["options"].(map[string]interface{})
+	if skipHeader, ok := options["skipHeader"]; !ok || skipHeader != nil {
+		t.Errorf("expected the removed skip_header to be sent as null, got options %v", options)
+	}
+	if options["fieldDelimiter"] != "," {
+		t.Errorf("expected field_delimiter to be updated, got options %v", options)
+	}
+
+	// The default read back for skip_header stays out of state.
+	var updated SnowflakeFileFormatResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &updated)...)
+	if !updated.CSV.Equal(plan.CSV) {
+		t.Errorf("expected state to match the plan:\nexpected: %s\ngot:      %s", plan.CSV, updated.CSV)
+	}
+}
@@ -4,13 +4,32 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/swcstudio/terraform-provider-ovh.snowflake/internal/sdk/identifiers"
+)
+
+var (
+	_ resource.Resource                 = &SnowflakeGrantResource{}
+	_ resource.ResourceWithImportState  = &SnowflakeGrantResource{}
+	_ resource.ResourceWithIdentity     = &SnowflakeGrantResource{}
+	_ resource.ResourceWithMoveState    = &SnowflakeGrantResource{}
+	_ resource.ResourceWithUpgradeState = &SnowflakeGrantResource{}
 )
 
-var _ resource.Resource = &SnowflakeGrantResource{}
+var grantIdentity = resourceIdentity{
+	{name: "privilege", description: "Privilege granted."},
+	{name: "object_type", description: "Type of the object the privilege is granted on."},
+	{name: "object_name", description: "Name of the object the privilege is granted on."},
+	{name: "role", description: "Role the privilege is granted to."},
+}
 
 func NewSnowflakeGrantResource() resource.Resource {
 	return &SnowflakeGrantResource{}
@@ -35,31 +54,54 @@ func (r *SnowflakeGrantResource) Metadata(ctx context.Context, req resource.Meta
 func (r *SnowflakeGrantResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages a Snowflake grant on OVH infrastructure.",
+		Version:     1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "Unique identifier for the grant.",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"privilege": schema.StringAttribute{
 				Description: "Privilege to grant (SELECT, INSERT, UPDATE, DELETE, etc.).",
 				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"object_type": schema.StringAttribute{
 				Description: "Type of object to grant privilege on (TABLE, DATABASE, SCHEMA, etc.).",
 				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"object_name": schema.StringAttribute{
 				Description: "Name of the object to grant privilege on.",
 				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"role": schema.StringAttribute{
 				Description: "Role to grant the privilege to.",
 				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					identifiers.ValidName(),
+				},
 			},
 		},
 	}
 }
 
+func (r *SnowflakeGrantResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = grantIdentity.schema()
+}
+
 func (r *SnowflakeGrantResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -92,15 +134,35 @@ func (r *SnowflakeGrantResource) Create(ctx context.Context, req resource.Create
 		"role":        data.Role.ValueString(),
 	})
 
-	data.ID = types.StringValue(fmt.Sprintf("grant-%s-%s-%s-%s",
-		data.Privilege.ValueString(),
-		data.ObjectType.ValueString(),
-		data.ObjectName.ValueString(),
-		data.Role.ValueString()))
+	grantConfig := map[string]interface{}{
+		"privilege":  data.Privilege.ValueString(),
+		"on":         data.ObjectType.ValueString(),
+		"objectName": data.ObjectName.ValueString(),
+		"toRole":     data.Role.ValueString(),
+	}
+
+	var result map[string]interface{}
+	err := r.config.OVHClient.Post("/cloud/project/snowflake/grant", grantConfig, &result)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Snowflake Grant",
+			fmt.Sprintf("Could not grant %s on %s %s to role %s: %s",
+				data.Privilege.ValueString(), data.ObjectType.ValueString(), data.ObjectName.ValueString(), data.Role.ValueString(), err),
+		)
+		return
+	}
+
+	data.ID = apiString(result, "id")
+
+	resp.Diagnostics.Append(r.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	tflog.Trace(ctx, "Created Snowflake grant")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(grantIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
 }
 
 func (r *SnowflakeGrantResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -115,9 +177,38 @@ func (r *SnowflakeGrantResource) Read(ctx context.Context, req resource.ReadRequ
 		"id": data.ID.ValueString(),
 	})
 
+	resp.Diagnostics.Append(resolveMissingID(r.config, "grant", "/cloud/project/snowflake/grant", map[string]string{
+		"privilege":  data.Privilege.ValueString(),
+		"on":         data.ObjectType.ValueString(),
+		"objectName": data.ObjectName.ValueString(),
+		"toRole":     data.Role.ValueString(),
+	}, &data.ID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var grant map[string]interface{}
+	err := r.config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/grant/%s", data.ID.ValueString()), &grant)
+	if isNotFoundError(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Snowflake Grant",
+			fmt.Sprintf("Could not read grant %s: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	data.refresh(grant)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(grantIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
 }
 
+// Update is never called with changes, as every attribute requires the grant
+// to be replaced.
 func (r *SnowflakeGrantResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data SnowflakeGrantResourceModel
 
@@ -131,6 +222,7 @@ func (r *SnowflakeGrantResource) Update(ctx context.Context, req resource.Update
 	})
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(grantIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
 }
 
 func (r *SnowflakeGrantResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -144,4 +236,172 @@ func (r *SnowflakeGrantResource) Delete(ctx context.Context, req resource.Delete
 	tflog.Debug(ctx, "Deleting Snowflake grant", map[string]interface{}{
 		"id": data.ID.ValueString(),
 	})
+
+	err := r.config.OVHClient.Delete(fmt.Sprintf("/cloud/project/snowflake/grant/%s", data.ID.ValueString()), nil)
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Snowflake Grant",
+			fmt.Sprintf("Could not revoke grant %s: %s", data.ID.ValueString(), err),
+		)
+	}
+}
+
+// ImportState accepts IDs of the form privilege|object_type|object_name|role.
+func (r *SnowflakeGrantResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = grantIdentity.importID(ctx, r.config, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if importByID(ctx, r.config, "grant", "/cloud/project/snowflake/grant", req.ID, resp) {
+		return
+	}
+
+	parts, err := splitImportID(req.ID, "privilege|object_type|object_name|role", 4, 4)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	importByLookup(ctx, r.config, "grant", "/cloud/project/snowflake/grant", map[string]string{
+		"privilege":  parts[0],
+		"on":         parts[1],
+		"objectName": parts[2],
+		"toRole":     parts[3],
+	}, resp)
+}
+
+// MoveState moves grants of a single privilege on a single named object from
+// the upstream Snowflake provider, whose grant resource was named
+// snowflake_grant_privileges_to_role before database roles were added.
+func (r *SnowflakeGrantResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		upstreamStateMover([]string{"snowflake_grant_privileges_to_account_role", "snowflake_grant_privileges_to_role"}, func(ctx context.Context, sourceType string, source upstreamState, resp *resource.MoveStateResponse) {
+			privileges := source.list("privileges")
+			if len(privileges) != 1 || source.bool("all_privileges").ValueBool() {
+				resp.Diagnostics.AddError(
+					"Unable to Move Snowflake Grant",
+					fmt.Sprintf("The %s resource grants %d privileges, but a grant holds exactly one. "+
+						"Split it into one resource per privilege before moving it.", sourceType, len(privileges)),
+				)
+				return
+			}
+
+			objectType, objectName, err := upstreamGrantObject(source)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Unable to Move Snowflake Grant",
+					fmt.Sprintf("The %s resource cannot be moved: %s", sourceType, err),
+				)
+				return
+			}
+
+			role := source.string("account_role_name")
+			if sourceType == "snowflake_grant_privileges_to_role" {
+				role = source.string("role_name")
+			}
+
+			data := SnowflakeGrantResourceModel{
+				Privilege:  types.StringValue(privileges[0]),
+				ObjectType: types.StringValue(objectType),
+				ObjectName: types.StringValue(objectName),
+				Role:       role,
+			}
+
+			resp.Diagnostics.Append(resp.TargetState.Set(ctx, &data)...)
+		}),
+	}
+}
+
+// snowflakeGrantResourceModelV0 is the state of the grant at version 0.
+type snowflakeGrantResourceModelV0 struct {
+	ID         types.String `tfsdk:"id"`
+	Privilege  types.String `tfsdk:"privilege"`
+	ObjectType types.String `tfsdk:"object_type"`
+	ObjectName types.String `tfsdk:"object_name"`
+	Role       types.String `tfsdk:"role"`
+}
+
+// UpgradeState upgrades state from version 0, which stored a placeholder
+// rather than the OVH ID of the grant.
+func (r *SnowflakeGrantResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":          schema.StringAttribute{Computed: true},
+					"privilege":   schema.StringAttribute{Required: true},
+					"object_type": schema.StringAttribute{Required: true},
+					"object_name": schema.StringAttribute{Required: true},
+					"role":        schema.StringAttribute{Required: true},
+				},
+			},
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var prior snowflakeGrantResourceModelV0
+				resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				data := SnowflakeGrantResourceModel{
+					ID:         upgradeStubID(prior.ID, "grant-%s-%s-%s-%s", prior.Privilege, prior.ObjectType, prior.ObjectName, prior.Role),
+					Privilege:  prior.Privilege,
+					ObjectType: prior.ObjectType,
+					ObjectName: prior.ObjectName,
+					Role:       prior.Role,
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			},
+		},
+	}
+}
+
+// upstreamGrantObject returns the type and name of the object an upstream
+// grant is on. Grants on the account and on all or future objects in a
+// database or schema have no counterpart here.
+func upstreamGrantObject(source upstreamState) (string, string, error) {
+	var objectType, objectName string
+	if on := source.block("on_account_object"); on != nil {
+		objectType, objectName = on.string("object_type").ValueString(), on.string("object_name").ValueString()
+	} else if on := source.block("on_schema"); on != nil {
+		objectType, objectName = "SCHEMA", on.string("schema_name").ValueString()
+	} else if on := source.block("on_schema_object"); on != nil {
+		objectType, objectName = on.string("object_type").ValueString(), on.string("object_name").ValueString()
+	}
+	if objectType == "" || objectName == "" {
+		return "", "", fmt.Errorf("only grants on a single named object are supported, not grants on the account or on all or future objects")
+	}
+
+	name, err := upstreamObjectName(objectName)
+	if err != nil {
+		return "", "", err
+	}
+	return objectType, name, nil
+}
+
+// read refreshes data from the OVH API after a create.
+func (r *SnowflakeGrantResource) read(ctx context.Context, data *SnowflakeGrantResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var grant map[string]interface{}
+	err := r.config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/grant/%s", data.ID.ValueString()), &grant)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Snowflake Grant",
+			fmt.Sprintf("Could not read grant %s: %s", data.ID.ValueString(), err),
+		)
+		return diags
+	}
+
+	data.refresh(grant)
+	return diags
+}
+
+// refresh copies an OVH API grant into the model.
+func (m *SnowflakeGrantResourceModel) refresh(grant map[string]interface{}) {
+	m.Privilege = apiString(grant, "privilege")
+	m.ObjectType = apiString(grant, "on")
+	m.ObjectName = apiString(grant, "objectName")
+	m.Role = apiString(grant, "toRole")
 }
@@ -0,0 +1,210 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/swcstudio/terraform-provider-ovh.snowflake/internal/sdk/identifiers"
+)
+
+var (
+	_ function.Function = &SnowflakeFullyQualifiedNameFunction{}
+	_ function.Function = &SnowflakeParseIdentifierFunction{}
+	_ function.Function = &SnowflakeQuoteIdentifierFunction{}
+	_ function.Function = &SnowflakeNormalizeWarehouseSizeFunction{}
+)
+
+func NewSnowflakeFullyQualifiedNameFunction() function.Function {
+	return &SnowflakeFullyQualifiedNameFunction{}
+}
+
+type SnowflakeFullyQualifiedNameFunction struct{}
+
+func (f *SnowflakeFullyQualifiedNameFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "fully_qualified_name"
+}
+
+func (f *SnowflakeFullyQualifiedNameFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build a database.schema.object identifier",
+		Description: "Joins a database, schema and object name into a fully qualified Snowflake identifier. " +
+			"Names are taken as Snowflake stores them, so a name that is not upper-case or contains special characters is quoted " +
+			"to keep its case; names that are already quoted are kept as they are.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "database",
+				Description: "Name of the database.",
+			},
+			function.StringParameter{
+				Name:        "schema",
+				Description: "Name of the schema.",
+			},
+			function.StringParameter{
+				Name:        "object",
+				Description: "Name of the object in the schema.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *SnowflakeFullyQualifiedNameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var database, schema, object string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &database, &schema, &object))
+	if resp.Error != nil {
+		return
+	}
+
+	names := []string{database, schema, object}
+	for i, name := range names {
+		// Accept names that are already quoted, e.g. from quote_identifier.
+		if strings.HasPrefix(name, `"`) {
+			if id, err := identifiers.ParseAccountObjectIdentifier(name); err == nil {
+				names[i] = id.Name()
+			}
+		}
+		if err := identifiers.ValidateName(names[i]); err != nil {
+			resp.Error = function.NewArgumentFuncError(int64(i), err.Error())
+			return
+		}
+	}
+
+	id := identifiers.NewSchemaObjectIdentifier(names[0], names[1], names[2])
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, id.FullyQualifiedName()))
+}
+
+func NewSnowflakeParseIdentifierFunction() function.Function {
+	return &SnowflakeParseIdentifierFunction{}
+}
+
+type SnowflakeParseIdentifierFunction struct{}
+
+func (f *SnowflakeParseIdentifierFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_identifier"
+}
+
+func (f *SnowflakeParseIdentifierFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Split a Snowflake identifier into its parts",
+		Description: "Splits a dotted Snowflake identifier of up to three parts into the names it refers to. " +
+			"Unquoted parts are upper-cased as Snowflake resolves them, while quoted parts keep their case and have their quotes removed.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "identifier",
+				Description: "Identifier to parse, for example analytics.public.\"Events\".",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f *SnowflakeParseIdentifierFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var identifier string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &identifier))
+	if resp.Error != nil {
+		return
+	}
+
+	parts, err := identifiers.ParseIdentifier(identifier)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	list, diags := types.ListValueFrom(ctx, types.StringType, parts)
+	resp.Error = function.FuncErrorFromDiags(ctx, diags)
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, list))
+}
+
+func NewSnowflakeQuoteIdentifierFunction() function.Function {
+	return &SnowflakeQuoteIdentifierFunction{}
+}
+
+type SnowflakeQuoteIdentifierFunction struct{}
+
+func (f *SnowflakeQuoteIdentifierFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "quote_identifier"
+}
+
+func (f *SnowflakeQuoteIdentifierFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Quote a Snowflake identifier",
+		Description: "Wraps a name in double quotes, escaping embedded double quotes, so that Snowflake uses it with its exact case and characters.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "name",
+				Description: "Name to quote.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *SnowflakeQuoteIdentifierFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &name))
+	if resp.Error != nil {
+		return
+	}
+
+	if err := identifiers.ValidateName(name); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, identifiers.Quote(name)))
+}
+
+func NewSnowflakeNormalizeWarehouseSizeFunction() function.Function {
+	return &SnowflakeNormalizeWarehouseSizeFunction{}
+}
+
+type SnowflakeNormalizeWarehouseSizeFunction struct{}
+
+func (f *SnowflakeNormalizeWarehouseSizeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "normalize_warehouse_size"
+}
+
+func (f *SnowflakeNormalizeWarehouseSizeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Normalize a warehouse size",
+		Description: "Returns the canonical spelling of a Snowflake warehouse size, such as X-SMALL or 2X-LARGE. " +
+			"Any case is accepted, as are the XXLARGE, X2LARGE and 2X-LARGE style variants.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "size",
+				Description: "Warehouse size to normalize.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *SnowflakeNormalizeWarehouseSizeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var size string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &size))
+	if resp.Error != nil {
+		return
+	}
+
+	normalized, err := normalizeWarehouseSize(size)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, normalized))
+}
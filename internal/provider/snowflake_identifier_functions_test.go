@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNormalizeWarehouseSize(t *testing.T) {
+	tests := map[string]string{
+		"xsmall":   "X-SMALL",
+		"X-Small":  "X-SMALL",
+		"x_small":  "X-SMALL",
+		"Medium":   "MEDIUM",
+		"XLARGE":   "X-LARGE",
+		"XXLARGE":  "2X-LARGE",
+		"X2LARGE":  "2X-LARGE",
+		"2x-large": "2X-LARGE",
+		"XXXLARGE": "3X-LARGE",
+		"X4LARGE":  "4X-LARGE",
+		"6X-Large": "6X-LARGE",
+	}
+
+	for size, want := range tests {
+		t.Run(size, func(t *testing.T) {
+			got, err := normalizeWarehouseSize(size)
+			if err != nil {
+				t.Fatalf("normalizeWarehouseSize(%q): %s", size, err)
+			}
+			if got != want {
+				t.Errorf("normalizeWarehouseSize(%q) = %s, want %s", size, got, want)
+			}
+		})
+	}
+
+	for _, size := range []string{"", "HUGE", "7X-LARGE"} {
+		if _, err := normalizeWarehouseSize(size); err == nil {
+			t.Errorf("normalizeWarehouseSize(%q) should fail", size)
+		}
+	}
+}
+
+func TestIdentifierFunctions_Run(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		fn      function.Function
+		args    []attr.Value
+		want    attr.Value
+		wantErr bool
+	}{
+		{
+			name: "fully_qualified_name",
+			fn:   NewSnowflakeFullyQualifiedNameFunction(),
+			args: []attr.Value{types.StringValue("ANALYTICS"), types.StringValue("public"), types.StringValue("EVENTS")},
+			want: types.StringValue(`ANALYTICS."public".EVENTS`),
+		},
+		{
+			name: "fully_qualified_name_quoted",
+			fn:   NewSnowflakeFullyQualifiedNameFunction(),
+			args: []attr.Value{types.StringValue(`"analytics"`), types.StringValue("PUBLIC"), types.StringValue(`say "hi"`)},
+			want: types.StringValue(`"analytics".PUBLIC."say ""hi"""`),
+		},
+		{
+			name:    "fully_qualified_name_empty",
+			fn:      NewSnowflakeFullyQualifiedNameFunction(),
+			args:    []attr.Value{types.StringValue("ANALYTICS"), types.StringValue(""), types.StringValue("EVENTS")},
+			wantErr: true,
+		},
+		{
+			name: "parse_identifier",
+			fn:   NewSnowflakeParseIdentifierFunction(),
+			args: []attr.Value{types.StringValue(`analytics."Public"`)},
+			want: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("ANALYTICS"), types.StringValue("Public")}),
+		},
+		{
+			name:    "parse_identifier_invalid",
+			fn:      NewSnowflakeParseIdentifierFunction(),
+			args:    []attr.Value{types.StringValue("a.b.c.d")},
+			wantErr: true,
+		},
+		{
+			name: "quote_identifier",
+			fn:   NewSnowflakeQuoteIdentifierFunction(),
+			args: []attr.Value{types.StringValue(`My "Table"`)},
+			want: types.StringValue(`"My ""Table"""`),
+		},
+		{
+			name: "normalize_warehouse_size",
+			fn:   NewSnowflakeNormalizeWarehouseSizeFunction(),
+			args: []attr.Value{types.StringValue("xxlarge")},
+			want: types.StringValue("2X-LARGE"),
+		},
+		{
+			name:    "normalize_warehouse_size_invalid",
+			fn:      NewSnowflakeNormalizeWarehouseSizeFunction(),
+			args:    []attr.Value{types.StringValue("HUGE")},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			definitionResp := &function.DefinitionResponse{}
+			tt.fn.Definition(ctx, function.DefinitionRequest{}, definitionResp)
+			result, err := definitionResp.Definition.Return.NewResultData(ctx)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			resp := &function.RunResponse{Result: result}
+			tt.fn.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData(tt.args)}, resp)
+
+			if (resp.Error != nil) != tt.wantErr {
+				t.Fatalf("unexpected error state: %v", resp.Error)
+			}
+			if !tt.wantErr && !resp.Result.Value().Equal(tt.want) {
+				t.Errorf("got %s, want %s", resp.Result.Value(), tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// identityAccountAttribute is the identity attribute holding the Snowflake
+// account, which every resource identity starts with.
+const identityAccountAttribute = "account"
+
+// resourceIdentity lists the state attributes that identify the object a
+// resource manages within a Snowflake account. Each one becomes an identity
+// attribute of the same name, in the order of the resource's import ID.
+type resourceIdentity []identityAttribute
+
+type identityAttribute struct {
+	name        string
+	description string
+	// optional attributes may be left out, e.g. the column of a tag
+	// association on a table.
+	optional bool
+}
+
+// accountObjectIdentity identifies objects that live in the account by name.
+func accountObjectIdentity(kind string) resourceIdentity {
+	return resourceIdentity{
+		{name: "name", description: fmt.Sprintf("Name of the %s.", kind)},
+	}
+}
+
+// databaseObjectIdentity identifies objects that live in a database.
+func databaseObjectIdentity(kind string) resourceIdentity {
+	return resourceIdentity{
+		{name: "database", description: fmt.Sprintf("Database that contains the %s.", kind)},
+		{name: "name", description: fmt.Sprintf("Name of the %s.", kind)},
+	}
+}
+
+// schemaObjectIdentity identifies objects that live in a schema.
+func schemaObjectIdentity(kind string) resourceIdentity {
+	return resourceIdentity{
+		{name: "database", description: fmt.Sprintf("Database that contains the %s.", kind)},
+		{name: "schema", description: fmt.Sprintf("Schema that contains the %s.", kind)},
+		{name: "name", description: fmt.Sprintf("Name of the %s.", kind)},
+	}
+}
+
+// schema returns the identity schema of the resource.
+func (i resourceIdentity) schema() identityschema.Schema {
+	attributes := map[string]identityschema.Attribute{
+		identityAccountAttribute: identityschema.StringAttribute{
+			Description:       "Snowflake account of the object, as configured on the provider.",
+			OptionalForImport: true,
+		},
+	}
+	for _, a := range i {
+		attributes[a.name] = identityschema.StringAttribute{
+			Description:       a.description,
+			RequiredForImport: !a.optional,
+			OptionalForImport: a.optional,
+		}
+	}
+	return identityschema.Schema{Attributes: attributes}
+}
+
+// set copies the identifying attributes of state into identity. It does
+// nothing when Terraform does not support resource identity.
+func (i resourceIdentity) set(ctx context.Context, config *Config, state tfsdk.State, identity *tfsdk.ResourceIdentity) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if identity == nil {
+		return diags
+	}
+
+	diags.Append(identity.SetAttribute(ctx, path.Root(identityAccountAttribute), identityAccount(config))...)
+	for _, a := range i {
+		var value types.String
+		diags.Append(state.GetAttribute(ctx, path.Root(a.name), &value)...)
+		diags.Append(identity.SetAttribute(ctx, path.Root(a.name), value)...)
+	}
+	return diags
+}
+
+// importID returns the ID to import. Imports by identity are turned into the
+// equivalent import ID, so that both forms go through the same lookup.
+// Errors are added to resp.
+func (i resourceIdentity) importID(ctx context.Context, config *Config, req resource.ImportStateRequest, resp *resource.ImportStateResponse) string {
+	if req.ID != "" || req.Identity == nil {
+		return req.ID
+	}
+
+	var account types.String
+	resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root(identityAccountAttribute), &account)...)
+	configured := identityAccount(config)
+	if !account.IsNull() && !configured.IsNull() && !strings.EqualFold(account.ValueString(), configured.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(identityAccountAttribute),
+			"Wrong Snowflake Account",
+			fmt.Sprintf("The identity belongs to account %s, but the provider is configured for account %s.",
+				account.ValueString(), configured.ValueString()),
+		)
+		return ""
+	}
+	// Record the account now so that the identity does not change on the
+	// read that follows the import.
+	if resp.Identity != nil {
+		resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root(identityAccountAttribute), configured)...)
+	}
+
+	var parts []string
+	for _, a := range i {
+		var value types.String
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root(a.name), &value)...)
+		if a.optional && value.ValueString() == "" {
+			continue
+		}
+		parts = append(parts, value.ValueString())
+	}
+	return strings.Join(parts, importIDSeparator)
+}
+
+// identityAccount returns the Snowflake account the provider is configured
+// for, or null when it is not set.
+func identityAccount(config *Config) types.String {
+	if config == nil || config.SnowflakeAccount == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(config.SnowflakeAccount)
+}
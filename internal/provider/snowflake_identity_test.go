@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// newIdentity returns an empty identity of the given resource identity.
+func newIdentity(ctx context.Context, identity resourceIdentity) *tfsdk.ResourceIdentity {
+	schema := identity.schema()
+	return &tfsdk.ResourceIdentity{
+		Schema: schema,
+		Raw:    tftypes.NewValue(schema.Type().TerraformType(ctx), nil),
+	}
+}
+
+func TestResourceIdentity_set(t *testing.T) {
+	ctx := context.Background()
+	config := &Config{SnowflakeAccount: "MYORG-ANALYTICS"}
+
+	schemaResp := &resource.SchemaResponse{}
+	NewSnowflakeTableResource().Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	data := SnowflakeTableResourceModel{}
+	data.refresh(map[string]interface{}{"name": "EVENTS", "database": "ANALYTICS", "schema": "PUBLIC"})
+	if diags := state.Set(ctx, &data); diags.HasError() {
+		t.Fatalf("state.Set: %v", diags)
+	}
+
+	identity := newIdentity(ctx, tableIdentity)
+	if diags := tableIdentity.set(ctx, config, state, identity); diags.HasError() {
+		t.Fatalf("set: %v", diags)
+	}
+
+	want := map[string]string{
+		"account":  "MYORG-ANALYTICS",
+		"database": "ANALYTICS",
+		"schema":   "PUBLIC",
+		"name":     "EVENTS",
+	}
+	for name, value := range want {
+		var got string
+		identity.GetAttribute(ctx, path.Root(name), &got)
+		if got != value {
+			t.Errorf("%s = %q, want %q", name, got, value)
+		}
+	}
+
+	// Terraform versions without identity support pass no identity.
+	if diags := tableIdentity.set(ctx, config, state, nil); diags.HasError() {
+		t.Errorf("set without identity: %v", diags)
+	}
+}
+
+func TestResourceIdentity_importID(t *testing.T) {
+	ctx := context.Background()
+	config := &Config{SnowflakeAccount: "MYORG-ANALYTICS"}
+
+	tests := []struct {
+		name     string
+		identity map[string]string
+		resource resourceIdentity
+		want     string
+		wantErr  bool
+	}{
+		{
+			name:     "schema object",
+			resource: tableIdentity,
+			identity: map[string]string{"database": "ANALYTICS", "schema": "PUBLIC", "name": "Events"},
+			want:     "ANALYTICS|PUBLIC|Events",
+		},
+		{
+			name:     "optional part left out",
+			resource: tagAssociationIdentity,
+			identity: map[string]string{"tag": "GOV.TAGS.PII", "object_type": "TABLE", "object_name": "ANALYTICS.PUBLIC.EVENTS"},
+			want:     "GOV.TAGS.PII|TABLE|ANALYTICS.PUBLIC.EVENTS",
+		},
+		{
+			name:     "matching account",
+			resource: warehouseIdentity,
+			identity: map[string]string{"account": "myorg-analytics", "name": "ANALYTICS_WH"},
+			want:     "ANALYTICS_WH",
+		},
+		{
+			name:     "other account",
+			resource: warehouseIdentity,
+			identity: map[string]string{"account": "MYORG-OTHER", "name": "ANALYTICS_WH"},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			identity := newIdentity(ctx, tt.resource)
+			for name, value := range tt.identity {
+				identity.SetAttribute(ctx, path.Root(name), value)
+			}
+
+			resp := &resource.ImportStateResponse{Identity: newIdentity(ctx, tt.resource)}
+			got := tt.resource.importID(ctx, config, resource.ImportStateRequest{Identity: identity}, resp)
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Fatalf("importID errors = %v, wantErr %v", resp.Diagnostics, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("importID = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	// Import IDs take precedence over identities.
+	resp := &resource.ImportStateResponse{}
+	if got := tableIdentity.importID(ctx, config, resource.ImportStateRequest{ID: "A|B|C"}, resp); got != "A|B|C" {
+		t.Errorf("importID = %q, want the import ID", got)
+	}
+}
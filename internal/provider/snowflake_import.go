@@ -0,0 +1,210 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/swcstudio/terraform-provider-ovh.snowflake/internal/sdk/identifiers"
+)
+
+// importIDSeparator separates the parts of a composite import ID, for example
+// ANALYTICS|PUBLIC|EVENTS.
+const importIDSeparator = "|"
+
+// maxIdentifierParts is the number of names in the longest object identifier,
+// database.schema.name.
+const maxIdentifierParts = 3
+
+// splitImportID splits a composite import ID of the given format, such as
+// "privilege|object_type|object_name|role", into between min and max parts.
+// Only trailing parts may be left out.
+func splitImportID(id, format string, min, max int) ([]string, error) {
+	parts := strings.Split(id, importIDSeparator)
+	if len(parts) < min || len(parts) > max {
+		return nil, fmt.Errorf("import ID %q must have the form %s", id, format)
+	}
+	for _, part := range parts {
+		if part == "" {
+			return nil, fmt.Errorf("import ID %q has an empty part, expected the form %s", id, format)
+		}
+	}
+	return parts, nil
+}
+
+// splitObjectImportID splits the import ID of a Snowflake object into the
+// count names it consists of, for example database|schema|name, taking each
+// name as it is. Names of objects in a database may also be given as a dotted
+// identifier such as analytics.public."Events", which is resolved the way
+// Snowflake resolves it.
+func splitObjectImportID(id string, count int) ([]string, error) {
+	format := []string{"database", "schema", "name"}[maxIdentifierParts-count:]
+
+	if !strings.Contains(id, importIDSeparator) && count > 1 {
+		names, err := identifiers.ParseIdentifier(id)
+		if err != nil || len(names) != count {
+			return nil, fmt.Errorf("import ID %q must have the form %s or be an identifier with %d dot-separated parts",
+				id, strings.Join(format, importIDSeparator), count)
+		}
+		return names, nil
+	}
+
+	names, err := splitImportID(id, strings.Join(format, importIDSeparator), count, count)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range names {
+		if err := identifiers.ValidateName(name); err != nil {
+			return nil, fmt.Errorf("import ID %q is invalid: %w", id, err)
+		}
+	}
+	return names, nil
+}
+
+// lookupImportID returns the OVH ID of the single object listed at endpoint
+// that matches the filters.
+func lookupImportID(config *Config, endpoint string, filters map[string]string) (string, error) {
+	keys := make([]string, 0, len(filters))
+	for key := range filters {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	query := url.Values{}
+	var description []string
+	for _, key := range keys {
+		query.Set(key, filters[key])
+		description = append(description, fmt.Sprintf("%s=%s", key, filters[key]))
+	}
+
+	var ids []string
+	if err := config.OVHClient.Get(endpoint+"?"+query.Encode(), &ids); err != nil {
+		return "", err
+	}
+
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("no object matches %s", strings.Join(description, ", "))
+	case 1:
+		return ids[0], nil
+	default:
+		return "", fmt.Errorf("%d objects match %s", len(ids), strings.Join(description, ", "))
+	}
+}
+
+// importByLookup resolves a human-readable import ID to the OVH ID of the
+// object, which the following Read uses to populate the rest of the state.
+func importByLookup(ctx context.Context, config *Config, kind, endpoint string, filters map[string]string, resp *resource.ImportStateResponse) {
+	id, err := lookupImportID(config, endpoint, filters)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Importing Snowflake "+importTitle(kind),
+			fmt.Sprintf("Could not find %s to import: %s", kind, err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// importByID imports the object listed at endpoint whose OVH ID is id, which
+// is the default import ID and the one the SDKv2 resources accept. It reports
+// whether id was such an ID; when it was not, the caller resolves it as names.
+// Composite and dotted IDs are never OVH IDs and are not looked up.
+func importByID(ctx context.Context, config *Config, kind, endpoint, id string, resp *resource.ImportStateResponse) bool {
+	if strings.Contains(id, importIDSeparator) {
+		return false
+	}
+	if names, err := identifiers.ParseIdentifier(id); err == nil && len(names) > 1 {
+		return false
+	}
+
+	var object map[string]interface{}
+	err := config.OVHClient.Get(fmt.Sprintf("%s/%s", endpoint, url.PathEscape(id)), &object)
+	if isNotFoundError(err) {
+		return false
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Importing Snowflake "+importTitle(kind),
+			fmt.Sprintf("Could not read %s %s: %s", kind, id, err),
+		)
+		return true
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	return true
+}
+
+// importSchemaObject imports an object that lives in a schema from its OVH ID
+// or an ID of the form database|schema|name.
+func importSchemaObject(ctx context.Context, config *Config, kind, endpoint string, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if importByID(ctx, config, kind, endpoint, req.ID, resp) {
+		return
+	}
+
+	names, err := splitObjectImportID(req.ID, 3)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	importByLookup(ctx, config, kind, endpoint, map[string]string{
+		"database": names[0],
+		"schema":   names[1],
+		"name":     names[2],
+	}, resp)
+}
+
+// importDatabaseObject imports an object that lives in a database from its
+// OVH ID or an ID of the form database|name.
+func importDatabaseObject(ctx context.Context, config *Config, kind, endpoint string, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if importByID(ctx, config, kind, endpoint, req.ID, resp) {
+		return
+	}
+
+	names, err := splitObjectImportID(req.ID, 2)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	importByLookup(ctx, config, kind, endpoint, map[string]string{
+		"database": names[0],
+		"name":     names[1],
+	}, resp)
+}
+
+// importAccountObject imports an object that lives in the account from its
+// OVH ID or its name. A name that is also the OVH ID of another object of the
+// same kind imports that object.
+func importAccountObject(ctx context.Context, config *Config, kind, endpoint string, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if importByID(ctx, config, kind, endpoint, req.ID, resp) {
+		return
+	}
+
+	names, err := splitObjectImportID(req.ID, 1)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	importByLookup(ctx, config, kind, endpoint, map[string]string{
+		"name": names[0],
+	}, resp)
+}
+
+// importTitle returns kind in title case for diagnostic summaries, e.g. "Row
+// Access Policy" for "row access policy".
+func importTitle(kind string) string {
+	words := strings.Fields(kind)
+	for i, word := range words {
+		words[i] = strings.ToUpper(word[:1]) + word[1:]
+	}
+	return strings.Join(words, " ")
+}
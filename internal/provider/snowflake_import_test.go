@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSplitImportID(t *testing.T) {
+	tests := []struct {
+		id      string
+		min     int
+		max     int
+		want    []string
+		wantErr bool
+	}{
+		{"SELECT|TABLE|DB.S.T|ANALYST", 4, 4, []string{"SELECT", "TABLE", "DB.S.T", "ANALYST"}, false},
+		{"PASSWORD", 1, 2, []string{"PASSWORD"}, false},
+		{"PASSWORD|JSMITH", 1, 2, []string{"PASSWORD", "JSMITH"}, false},
+		{"PASSWORD|JSMITH|X", 1, 2, nil, true},
+		{"SELECT||DB.S.T|ANALYST", 4, 4, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			got, err := splitImportID(tt.id, "format", tt.min, tt.max)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("splitImportID(%q) error = %v, wantErr %v", tt.id, err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("splitImportID(%q) = %q, want %q", tt.id, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplitObjectImportID(t *testing.T) {
+	tests := []struct {
+		id      string
+		count   int
+		want    []string
+		wantErr bool
+	}{
+		{"analytics_wh", 1, []string{"analytics_wh"}, false},
+		{"Analytics WH", 1, []string{"Analytics WH"}, false},
+		{"ANALYTICS|PUBLIC", 2, []string{"ANALYTICS", "PUBLIC"}, false},
+		{"analytics|public|order items", 3, []string{"analytics", "public", "order items"}, false},
+		{`analytics.public."Events"`, 3, []string{"ANALYTICS", "PUBLIC", "Events"}, false},
+		{"analytics.public", 3, nil, true},
+		{"analytics|public", 3, nil, true},
+		{"", 1, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			got, err := splitObjectImportID(tt.id, tt.count)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("splitObjectImportID(%q) error = %v, wantErr %v", tt.id, err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("splitObjectImportID(%q) = %q, want %q", tt.id, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSnowflakeTableResource_ImportStateInvalidID(t *testing.T) {
+	ctx := context.Background()
+	r := NewSnowflakeTableResource().(*SnowflakeTableResource)
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	// Invalid IDs are rejected before the API is queried.
+	for _, id := range []string{"ANALYTICS|PUBLIC", "ANALYTICS||EVENTS", "analytics.public"} {
+		resp := &resource.ImportStateResponse{
+			State: tfsdk.State{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			},
+		}
+		r.ImportState(ctx, resource.ImportStateRequest{ID: id}, resp)
+		if !resp.Diagnostics.HasError() {
+			t.Errorf("expected an error importing %q", id)
+		}
+	}
+}
+
+func TestImportState_OVHID(t *testing.T) {
+	tests := []struct {
+		name     string
+		resource resource.Resource
+		id       string
+		routes   map[string]interface{}
+		wantID   string
+	}{
+		{
+			// The default import ID is the OVH ID, which is used as it is.
+			name:     "warehouse OVH ID",
+			resource: NewSnowflakeWarehouseResource(),
+			id:       "8f2c1e0a",
+			routes: map[string]interface{}{
+				"GET /cloud/project/snowflake/warehouse/8f2c1e0a": map[string]interface{}{"id": "8f2c1e0a", "name": "ANALYTICS_WH"},
+			},
+			wantID: "8f2c1e0a",
+		},
+		{
+			name:     "warehouse name",
+			resource: NewSnowflakeWarehouseResource(),
+			id:       "ANALYTICS_WH",
+			routes: map[string]interface{}{
+				"GET /cloud/project/snowflake/warehouse?name=ANALYTICS_WH": []string{"8f2c1e0a"},
+			},
+			wantID: "8f2c1e0a",
+		},
+		{
+			name:     "table OVH ID",
+			resource: NewSnowflakeTableResource(),
+			id:       "3b7d9c41",
+			routes: map[string]interface{}{
+				"GET /cloud/project/snowflake/table/3b7d9c41": map[string]interface{}{"id": "3b7d9c41"},
+			},
+			wantID: "3b7d9c41",
+		},
+		{
+			name:     "grant OVH ID",
+			resource: NewSnowflakeGrantResource(),
+			id:       "51ac02fe",
+			routes: map[string]interface{}{
+				"GET /cloud/project/snowflake/grant/51ac02fe": map[string]interface{}{"id": "51ac02fe"},
+			},
+			wantID: "51ac02fe",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			_, config := newFakeOVHAPI(t, tt.routes)
+			tt.resource.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: config}, &resource.ConfigureResponse{})
+
+			schemaResp := &resource.SchemaResponse{}
+			tt.resource.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+			resp := &resource.ImportStateResponse{
+				State: tfsdk.State{
+					Schema: schemaResp.Schema,
+					Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+				},
+			}
+
+			tt.resource.(resource.ResourceWithImportState).ImportState(ctx, resource.ImportStateRequest{ID: tt.id}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("import failed: %v", resp.Diagnostics)
+			}
+
+			var id types.String
+			resp.State.GetAttribute(ctx, path.Root("id"), &id)
+			if id.ValueString() != tt.wantID {
+				t.Errorf("id = %s, want %s", id, tt.wantID)
+			}
+		})
+	}
+}
@@ -0,0 +1,178 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/swcstudio/terraform-provider-ovh.snowflake/internal/sdk/identifiers"
+)
+
+var _ datasource.DataSource = &SnowflakeInboundSharesDataSource{}
+
+func NewSnowflakeInboundSharesDataSource() datasource.DataSource {
+	return &SnowflakeInboundSharesDataSource{}
+}
+
+type SnowflakeInboundSharesDataSource struct {
+	config *Config
+}
+
+type SnowflakeInboundSharesDataSourceModel struct {
+	ID              types.String                            `tfsdk:"id"`
+	ProviderAccount types.String                            `tfsdk:"provider_account"`
+	Like            types.String                            `tfsdk:"like"`
+	Shares          []SnowflakeInboundSharesDataSourceShare `tfsdk:"shares"`
+}
+
+type SnowflakeInboundSharesDataSourceShare struct {
+	Name            types.String `tfsdk:"name"`
+	ProviderAccount types.String `tfsdk:"provider_account"`
+	FromShare       types.String `tfsdk:"from_share"`
+	DatabaseName    types.String `tfsdk:"database_name"`
+	Comment         types.String `tfsdk:"comment"`
+	CreatedOn       types.String `tfsdk:"created_on"`
+}
+
+func (d *SnowflakeInboundSharesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_inbound_shares"
+}
+
+func (d *SnowflakeInboundSharesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists Snowflake shares that provider accounts have made available to the current account on OVH infrastructure.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Unique identifier for this data source.",
+				Computed:    true,
+			},
+			"provider_account": schema.StringAttribute{
+				Description: "Only list shares from this provider account.",
+				Optional:    true,
+			},
+			"like": schema.StringAttribute{
+				Description: "Only list shares whose name matches this SQL LIKE pattern (case-insensitive).",
+				Optional:    true,
+			},
+			"shares": schema.ListNestedAttribute{
+				Description: "Inbound shares.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Share name.",
+							Computed:    true,
+						},
+						"provider_account": schema.StringAttribute{
+							Description: "Account that provides the share.",
+							Computed:    true,
+						},
+						"from_share": schema.StringAttribute{
+							Description: "Share identifier to use in the from_share argument of a database.",
+							Computed:    true,
+						},
+						"database_name": schema.StringAttribute{
+							Description: "Database created from the share, if any.",
+							Computed:    true,
+						},
+						"comment": schema.StringAttribute{
+							Description: "Share comment.",
+							Computed:    true,
+						},
+						"created_on": schema.StringAttribute{
+							Description: "Creation timestamp of the share.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SnowflakeInboundSharesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.config = config
+}
+
+func (d *SnowflakeInboundSharesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SnowflakeInboundSharesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Snowflake inbound shares data source", map[string]interface{}{
+		"provider_account": data.ProviderAccount.ValueString(),
+		"like":             data.Like.ValueString(),
+	})
+
+	query := url.Values{}
+	if !data.ProviderAccount.IsNull() {
+		query.Set("providerAccount", data.ProviderAccount.ValueString())
+	}
+	if !data.Like.IsNull() {
+		query.Set("like", data.Like.ValueString())
+	}
+
+	endpoint := "/cloud/project/snowflake/share/inbound"
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+
+	var shares []map[string]interface{}
+	err := d.config.OVHClient.Get(endpoint, &shares)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Snowflake Inbound Shares",
+			fmt.Sprintf("Could not list inbound shares: %s", err),
+		)
+		return
+	}
+
+	data.ID = types.StringValue("snowflake-inbound-shares")
+	data.Shares = make([]SnowflakeInboundSharesDataSourceShare, 0, len(shares))
+	for _, share := range shares {
+		name := apiString(share, "name")
+		providerAccount := apiString(share, "providerAccount")
+		data.Shares = append(data.Shares, SnowflakeInboundSharesDataSourceShare{
+			Name:            name,
+			ProviderAccount: providerAccount,
+			FromShare:       types.StringValue(inboundShareIdentifier(providerAccount.ValueString(), name.ValueString())),
+			DatabaseName:    apiOptionalString(share, "databaseName"),
+			Comment:         apiOptionalString(share, "comment"),
+			CreatedOn:       apiString(share, "createdOn"),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// inboundShareIdentifier returns the identifier of a share as used in
+// from_share. providerAccount is either an account locator or an
+// organization.account name.
+func inboundShareIdentifier(providerAccount, name string) string {
+	if organization, account, ok := strings.Cut(providerAccount, "."); ok {
+		return identifiers.NewSchemaObjectIdentifier(organization, account, name).FullyQualifiedName()
+	}
+	return identifiers.NewDatabaseObjectIdentifier(providerAccount, name).FullyQualifiedName()
+}
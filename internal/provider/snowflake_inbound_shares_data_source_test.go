@@ -0,0 +1,23 @@
+package provider
+
+import "testing"
+
+func TestInboundShareIdentifier(t *testing.T) {
+	testCases := map[string]struct {
+		providerAccount string
+		name            string
+		expected        string
+	}{
+		"locator":      {providerAccount: "AB12345", name: "SALES", expected: "AB12345.SALES"},
+		"organization": {providerAccount: "MYORG.PROVIDER", name: "SALES", expected: "MYORG.PROVIDER.SALES"},
+		"quoted_name":  {providerAccount: "AB12345", name: "sales data", expected: `AB12345."sales data"`},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := inboundShareIdentifier(tc.providerAccount, tc.name); got != tc.expected {
+				t.Errorf("expected %s, got %s", tc.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,321 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/swcstudio/terraform-provider-ovh.snowflake/internal/sdk/identifiers"
+)
+
+var (
+	_ resource.Resource                = &SnowflakeMaskingPolicyAttachmentResource{}
+	_ resource.ResourceWithImportState = &SnowflakeMaskingPolicyAttachmentResource{}
+	_ resource.ResourceWithIdentity    = &SnowflakeMaskingPolicyAttachmentResource{}
+)
+
+var maskingPolicyAttachmentIdentity = resourceIdentity{
+	{name: "object_type", description: "Type of the object containing the masked column."},
+	{name: "object_name", description: "Fully qualified name of the object containing the masked column."},
+	{name: "column", description: "Masked column."},
+}
+
+func NewSnowflakeMaskingPolicyAttachmentResource() resource.Resource {
+	return &SnowflakeMaskingPolicyAttachmentResource{}
+}
+
+type SnowflakeMaskingPolicyAttachmentResource struct {
+	config *Config
+}
+
+type SnowflakeMaskingPolicyAttachmentResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Policy       types.String `tfsdk:"policy"`
+	ObjectType   types.String `tfsdk:"object_type"`
+	ObjectName   types.String `tfsdk:"object_name"`
+	Column       types.String `tfsdk:"column"`
+	UsingColumns types.List   `tfsdk:"using_columns"`
+}
+
+// policyObjectTypes are the object types policies can be attached to.
+var policyObjectTypes = []string{"TABLE", "VIEW"}
+
+func (r *SnowflakeMaskingPolicyAttachmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_masking_policy_attachment"
+}
+
+func (r *SnowflakeMaskingPolicyAttachmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Attaches a Snowflake masking policy to a table or view column on OVH infrastructure. Changing any argument detaches the policy and attaches it again.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Unique identifier for the attachment.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"policy": schema.StringAttribute{
+				Description: "Fully qualified name of the masking policy.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					identifiers.ValidSchemaObjectIdentifier(),
+				},
+			},
+			"object_type": schema.StringAttribute{
+				Description: "Type of the object that contains the column (TABLE or VIEW).",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("TABLE"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					oneOfValidator{values: policyObjectTypes},
+				},
+			},
+			"object_name": schema.StringAttribute{
+				Description: "Fully qualified name of the table or view.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"column": schema.StringAttribute{
+				Description: "Column the policy masks.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"using_columns": schema.ListAttribute{
+				Description: "Columns passed to a conditional masking policy, starting with the masked column. Defaults to the masked column only.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SnowflakeMaskingPolicyAttachmentResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = maskingPolicyAttachmentIdentity.schema()
+}
+
+func (r *SnowflakeMaskingPolicyAttachmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.config = config
+}
+
+func (r *SnowflakeMaskingPolicyAttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SnowflakeMaskingPolicyAttachmentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Attaching Snowflake masking policy", map[string]interface{}{
+		"policy": data.Policy.ValueString(),
+		"object": data.ObjectName.ValueString(),
+		"column": data.Column.ValueString(),
+	})
+
+	var usingColumns []types.String
+	resp.Diagnostics.Append(data.UsingColumns.ElementsAs(ctx, &usingColumns, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attachmentConfig := map[string]interface{}{
+		"policy":       data.Policy.ValueString(),
+		"objectType":   data.ObjectType.ValueString(),
+		"objectName":   data.ObjectName.ValueString(),
+		"column":       data.Column.ValueString(),
+		"usingColumns": stringValues(usingColumns),
+	}
+
+	var result map[string]interface{}
+	err := r.config.OVHClient.Post("/cloud/project/snowflake/masking-policy-attachment", attachmentConfig, &result)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Snowflake Masking Policy Attachment",
+			fmt.Sprintf("Could not attach masking policy %s to %s.%s: %s",
+				data.Policy.ValueString(), data.ObjectName.ValueString(), data.Column.ValueString(), err),
+		)
+		return
+	}
+
+	data.ID = apiString(result, "id")
+
+	resp.Diagnostics.Append(r.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Attached Snowflake masking policy")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(maskingPolicyAttachmentIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
+}
+
+func (r *SnowflakeMaskingPolicyAttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SnowflakeMaskingPolicyAttachmentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Snowflake masking policy attachment", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	var attachment map[string]interface{}
+	err := r.config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/masking-policy-attachment/%s", data.ID.ValueString()), &attachment)
+	if isNotFoundError(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Snowflake Masking Policy Attachment",
+			fmt.Sprintf("Could not read masking policy attachment %s: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(data.refresh(ctx, attachment)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(maskingPolicyAttachmentIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
+}
+
+// Update is never called with changes because every argument requires
+// replacement; it only carries the plan over to state.
+func (r *SnowflakeMaskingPolicyAttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SnowflakeMaskingPolicyAttachmentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(maskingPolicyAttachmentIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
+}
+
+func (r *SnowflakeMaskingPolicyAttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SnowflakeMaskingPolicyAttachmentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Detaching Snowflake masking policy", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.config.OVHClient.Delete(fmt.Sprintf("/cloud/project/snowflake/masking-policy-attachment/%s", data.ID.ValueString()), nil)
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Snowflake Masking Policy Attachment",
+			fmt.Sprintf("Could not detach masking policy %s from %s.%s: %s",
+				data.Policy.ValueString(), data.ObjectName.ValueString(), data.Column.ValueString(), err),
+		)
+	}
+}
+
+// ImportState accepts IDs of the form object_type|object_name|column, as a
+// column has at most one masking policy.
+func (r *SnowflakeMaskingPolicyAttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = maskingPolicyAttachmentIdentity.importID(ctx, r.config, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if importByID(ctx, r.config, "masking policy attachment", "/cloud/project/snowflake/masking-policy-attachment", req.ID, resp) {
+		return
+	}
+
+	parts, err := splitImportID(req.ID, "object_type|object_name|column", 3, 3)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	importByLookup(ctx, r.config, "masking policy attachment", "/cloud/project/snowflake/masking-policy-attachment", map[string]string{
+		"objectType": strings.ToUpper(parts[0]),
+		"objectName": parts[1],
+		"column":     parts[2],
+	}, resp)
+}
+
+// read refreshes data from the OVH API after a create.
+func (r *SnowflakeMaskingPolicyAttachmentResource) read(ctx context.Context, data *SnowflakeMaskingPolicyAttachmentResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var attachment map[string]interface{}
+	err := r.config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/masking-policy-attachment/%s", data.ID.ValueString()), &attachment)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Snowflake Masking Policy Attachment",
+			fmt.Sprintf("Could not read masking policy attachment %s: %s", data.ID.ValueString(), err),
+		)
+		return diags
+	}
+
+	return data.refresh(ctx, attachment)
+}
+
+// refresh copies an OVH API masking policy attachment into the model.
+func (m *SnowflakeMaskingPolicyAttachmentResourceModel) refresh(ctx context.Context, attachment map[string]interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.Policy = apiIdentifier(attachment, "policy", m.Policy)
+	m.ObjectType = apiString(attachment, "objectType")
+	m.ObjectName = apiIdentifier(attachment, "objectName", m.ObjectName)
+	m.Column = apiIdentifier(attachment, "column", m.Column)
+
+	// USING defaults to the masked column alone; keep it unset in that case.
+	usingColumns := apiStringList(attachment, "usingColumns")
+	if len(usingColumns) > 1 || !m.UsingColumns.IsNull() && len(usingColumns) > 0 {
+		list, d := types.ListValueFrom(ctx, types.StringType, usingColumns)
+		diags.Append(d...)
+		m.UsingColumns = list
+	}
+
+	return diags
+}
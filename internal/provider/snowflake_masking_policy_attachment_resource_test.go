@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSnowflakeMaskingPolicyAttachment_RefreshKeepsConfiguredNames(t *testing.T) {
+	m := SnowflakeMaskingPolicyAttachmentResourceModel{
+		Policy:       types.StringValue("governance.policies.mask_email"),
+		ObjectType:   types.StringValue("TABLE"),
+		ObjectName:   types.StringValue("analytics.public.users"),
+		Column:       types.StringValue("email"),
+		UsingColumns: types.ListNull(types.StringType),
+	}
+
+	diags := m.refresh(context.Background(), map[string]interface{}{
+		"policy":     "GOVERNANCE.POLICIES.MASK_EMAIL",
+		"objectType": "TABLE",
+		"objectName": "ANALYTICS.PUBLIC.USERS",
+		"column":     "EMAIL",
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if m.Policy.ValueString() != "governance.policies.mask_email" ||
+		m.ObjectName.ValueString() != "analytics.public.users" ||
+		m.Column.ValueString() != "email" {
+		t.Errorf("expected the configured spelling to be kept, got %s, %s and %s", m.Policy, m.ObjectName, m.Column)
+	}
+
+	// A policy swapped outside Terraform still shows up as a change.
+	diags = m.refresh(context.Background(), map[string]interface{}{
+		"policy":     "GOVERNANCE.POLICIES.MASK_ALL",
+		"objectType": "TABLE",
+		"objectName": "ANALYTICS.PUBLIC.USERS",
+		"column":     "EMAIL",
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if m.Policy.ValueString() != "GOVERNANCE.POLICIES.MASK_ALL" {
+		t.Errorf("expected the attached policy to be read, got %s", m.Policy)
+	}
+}
+
+func TestSnowflakeRowAccessPolicyAttachment_RefreshKeepsConfiguredNames(t *testing.T) {
+	m := SnowflakeRowAccessPolicyAttachmentResourceModel{
+		Policy:     types.StringValue(`governance.policies."Region Filter"`),
+		ObjectType: types.StringValue("TABLE"),
+		ObjectName: types.StringValue("analytics.public.orders"),
+	}
+
+	diags := m.refresh(context.Background(), map[string]interface{}{
+		"policy":     `GOVERNANCE.POLICIES."Region Filter"`,
+		"objectType": "TABLE",
+		"objectName": "ANALYTICS.PUBLIC.ORDERS",
+		"on":         []interface{}{"REGION"},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if m.Policy.ValueString() != `governance.policies."Region Filter"` || m.ObjectName.ValueString() != "analytics.public.orders" {
+		t.Errorf("expected the configured spelling to be kept, got %s and %s", m.Policy, m.ObjectName)
+	}
+}
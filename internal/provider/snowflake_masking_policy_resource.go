@@ -0,0 +1,559 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/swcstudio/terraform-provider-ovh.snowflake/internal/sdk/identifiers"
+)
+
+var (
+	_ resource.Resource                = &SnowflakeMaskingPolicyResource{}
+	_ resource.ResourceWithImportState = &SnowflakeMaskingPolicyResource{}
+	_ resource.ResourceWithIdentity    = &SnowflakeMaskingPolicyResource{}
+)
+
+var maskingPolicyIdentity = schemaObjectIdentity("masking policy")
+
+func NewSnowflakeMaskingPolicyResource() resource.Resource {
+	return &SnowflakeMaskingPolicyResource{}
+}
+
+type SnowflakeMaskingPolicyResource struct {
+	config *Config
+}
+
+type SnowflakeMaskingPolicyResourceModel struct {
+	ID                  types.String      `tfsdk:"id"`
+	Name                types.String      `tfsdk:"name"`
+	Database            types.String      `tfsdk:"database"`
+	Schema              types.String      `tfsdk:"schema"`
+	Signature           types.List        `tfsdk:"signature"`
+	ReturnType          types.String      `tfsdk:"return_type"`
+	Body                SQLStatementValue `tfsdk:"body"`
+	ExemptOtherPolicies types.Bool        `tfsdk:"exempt_other_policies"`
+	Comment             types.String      `tfsdk:"comment"`
+	FullyQualifiedName  types.String      `tfsdk:"fully_qualified_name"`
+	Owner               types.String      `tfsdk:"owner"`
+	CreatedOn           types.String      `tfsdk:"created_on"`
+}
+
+// SnowflakePolicyArgumentModel describes an argument in the signature of a
+// masking or row access policy.
+type SnowflakePolicyArgumentModel struct {
+	Name types.String `tfsdk:"name"`
+	Type types.String `tfsdk:"type"`
+}
+
+var policyArgumentAttrTypes = map[string]attr.Type{
+	"name": types.StringType,
+	"type": types.StringType,
+}
+
+// policySignatureAttribute is the signature schema shared by masking and row
+// access policies.
+func policySignatureAttribute(description string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Description: description + " Changing it recreates the policy.",
+		Required:    true,
+		PlanModifiers: []planmodifier.List{
+			listplanmodifier.RequiresReplace(),
+		},
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					Description: "Name of the argument.",
+					Required:    true,
+					Validators: []validator.String{
+						identifiers.ValidName(),
+					},
+				},
+				"type": schema.StringAttribute{
+					Description: "Data type of the argument.",
+					Required:    true,
+				},
+			},
+		},
+	}
+}
+
+// expandPolicySignature converts the signature attribute into the OVH API
+// payload.
+func expandPolicySignature(ctx context.Context, signature types.List) ([]map[string]interface{}, diag.Diagnostics) {
+	result := []map[string]interface{}{}
+	if signature.IsNull() || signature.IsUnknown() {
+		return result, nil
+	}
+
+	var models []SnowflakePolicyArgumentModel
+	diags := signature.ElementsAs(ctx, &models, false)
+	for _, argument := range models {
+		result = append(result, map[string]interface{}{
+			"name": argument.Name.ValueString(),
+			"type": argument.Type.ValueString(),
+		})
+	}
+	return result, diags
+}
+
+// flattenPolicySignature converts an OVH API signature into the signature
+// attribute. The configured signature is kept when Snowflake only reports it
+// differently, e.g. VARCHAR(16777216) for STRING.
+func flattenPolicySignature(ctx context.Context, apiSignature interface{}, current types.List) (types.List, diag.Diagnostics) {
+	raw, _ := apiSignature.([]interface{})
+	models := make([]SnowflakePolicyArgumentModel, 0, len(raw))
+	for _, item := range raw {
+		argument, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		models = append(models, SnowflakePolicyArgumentModel{
+			Name: apiString(argument, "name"),
+			Type: apiString(argument, "type"),
+		})
+	}
+
+	if !current.IsNull() && !current.IsUnknown() {
+		var configured []SnowflakePolicyArgumentModel
+		diags := current.ElementsAs(ctx, &configured, false)
+		if diags.HasError() {
+			return current, diags
+		}
+		if policySignatureEqual(configured, models) {
+			return current, nil
+		}
+	}
+
+	return types.ListValueFrom(ctx, types.ObjectType{AttrTypes: policyArgumentAttrTypes}, models)
+}
+
+// policySignatureEqual reports whether two signatures declare the same
+// arguments, comparing names case-insensitively and types with dataTypeEqual.
+func policySignatureEqual(a, b []SnowflakePolicyArgumentModel) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !strings.EqualFold(a[i].Name.ValueString(), b[i].Name.ValueString()) ||
+			!dataTypeEqual(a[i].Type.ValueString(), b[i].Type.ValueString()) {
+			return false
+		}
+	}
+	return true
+}
+
+var dataTypeSynonyms = map[string]string{
+	"STRING":    "VARCHAR",
+	"TEXT":      "VARCHAR",
+	"CHAR":      "VARCHAR",
+	"CHARACTER": "VARCHAR",
+	"INT":       "NUMBER",
+	"INTEGER":   "NUMBER",
+	"BIGINT":    "NUMBER",
+	"SMALLINT":  "NUMBER",
+	"DECIMAL":   "NUMBER",
+	"NUMERIC":   "NUMBER",
+	"DOUBLE":    "FLOAT",
+	"REAL":      "FLOAT",
+	"DATETIME":  "TIMESTAMP_NTZ",
+}
+
+var dataTypeParams = regexp.MustCompile(`\s*\(.*\)\s*$`)
+
+// dataTypeEqual reports whether two Snowflake data types are the same,
+// ignoring synonyms and size parameters Snowflake fills in with defaults.
+func dataTypeEqual(a, b string) bool {
+	return normalizeDataType(a) == normalizeDataType(b)
+}
+
+func normalizeDataType(dataType string) string {
+	base := strings.ToUpper(strings.TrimSpace(dataTypeParams.ReplaceAllString(dataType, "")))
+	if synonym, ok := dataTypeSynonyms[base]; ok {
+		return synonym
+	}
+	return base
+}
+
+// policyReferences returns the objects a policy is attached to, read from
+// the references endpoint of the policy at path.
+func policyReferences(config *Config, path string) ([]string, error) {
+	var raw []interface{}
+	if err := config.OVHClient.Get(path+"/references", &raw); err != nil {
+		return nil, err
+	}
+
+	var references []string
+	for _, item := range raw {
+		reference, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := reference["objectName"].(string)
+		if column, _ := reference["columnName"].(string); column != "" {
+			name += "." + column
+		}
+		references = append(references, name)
+	}
+	return references, nil
+}
+
+func (r *SnowflakeMaskingPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_masking_policy"
+}
+
+func (r *SnowflakeMaskingPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Snowflake masking policy on OVH infrastructure. Attach it to columns with snowflake-ovh_masking_policy_attachment.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Unique identifier for the masking policy.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the masking policy.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					identifiers.ValidName(),
+				},
+			},
+			"database": schema.StringAttribute{
+				Description: "Database that contains the masking policy.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					identifiers.ValidName(),
+				},
+			},
+			"schema": schema.StringAttribute{
+				Description: "Schema that contains the masking policy.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					identifiers.ValidName(),
+				},
+			},
+			"signature": policySignatureAttribute("Arguments of the policy. The first argument is the masked column; further arguments are columns used for conditional masking."),
+			"return_type": schema.StringAttribute{
+				Description: "Data type returned by the policy; must match the type of the first argument. Changing it recreates the policy.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"body": schema.StringAttribute{
+				Description: "SQL expression that returns the masked value. Changes are applied in place.",
+				Required:    true,
+				CustomType:  SQLStatementType{},
+			},
+			"exempt_other_policies": schema.BoolAttribute{
+				Description: "Whether a row access policy or conditional masking policy on the same object may reference the masked column. Changing it recreates the policy.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"comment": schema.StringAttribute{
+				Description: "Comment for the masking policy.",
+				Optional:    true,
+			},
+			"fully_qualified_name": schema.StringAttribute{
+				Description: "Fully qualified name of the masking policy.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"owner": schema.StringAttribute{
+				Description: "Role that owns the masking policy.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_on": schema.StringAttribute{
+				Description: "Creation timestamp of the masking policy.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SnowflakeMaskingPolicyResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = maskingPolicyIdentity.schema()
+}
+
+func (r *SnowflakeMaskingPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.config = config
+}
+
+func (r *SnowflakeMaskingPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SnowflakeMaskingPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating Snowflake masking policy", map[string]interface{}{
+		"name":     data.Name.ValueString(),
+		"database": data.Database.ValueString(),
+		"schema":   data.Schema.ValueString(),
+	})
+
+	signature, diags := expandPolicySignature(ctx, data.Signature)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policyConfig := map[string]interface{}{
+		"name":                data.Name.ValueString(),
+		"database":            data.Database.ValueString(),
+		"schema":              data.Schema.ValueString(),
+		"signature":           signature,
+		"returnType":          data.ReturnType.ValueString(),
+		"body":                data.Body.ValueString(),
+		"exemptOtherPolicies": data.ExemptOtherPolicies.ValueBool(),
+		"comment":             data.Comment.ValueString(),
+	}
+
+	var result map[string]interface{}
+	err := r.config.OVHClient.Post("/cloud/project/snowflake/masking-policy", policyConfig, &result)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Snowflake Masking Policy",
+			fmt.Sprintf("Could not create masking policy %s: %s", data.Name.ValueString(), err),
+		)
+		return
+	}
+
+	data.ID = apiString(result, "id")
+
+	resp.Diagnostics.Append(r.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Created Snowflake masking policy")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(maskingPolicyIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
+}
+
+func (r *SnowflakeMaskingPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SnowflakeMaskingPolicyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Snowflake masking policy", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	var policy map[string]interface{}
+	err := r.config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/masking-policy/%s", data.ID.ValueString()), &policy)
+	if isNotFoundError(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Snowflake Masking Policy",
+			fmt.Sprintf("Could not read masking policy %s: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(data.refresh(ctx, policy)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(maskingPolicyIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
+}
+
+func (r *SnowflakeMaskingPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state SnowflakeMaskingPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating Snowflake masking policy", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	updateConfig := map[string]interface{}{}
+
+	// ALTER MASKING POLICY ... SET BODY keeps existing attachments in place.
+	if !sqlEquivalent(data.Body.ValueString(), state.Body.ValueString()) {
+		updateConfig["body"] = data.Body.ValueString()
+	}
+	if !data.Comment.Equal(state.Comment) {
+		updateConfig["comment"] = data.Comment.ValueString()
+	}
+
+	if len(updateConfig) > 0 {
+		err := r.config.OVHClient.Put(fmt.Sprintf("/cloud/project/snowflake/masking-policy/%s", data.ID.ValueString()), updateConfig, nil)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Snowflake Masking Policy",
+				fmt.Sprintf("Could not update masking policy %s: %s", data.ID.ValueString(), err),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(maskingPolicyIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
+}
+
+func (r *SnowflakeMaskingPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SnowflakeMaskingPolicyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting Snowflake masking policy", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	path := fmt.Sprintf("/cloud/project/snowflake/masking-policy/%s", data.ID.ValueString())
+
+	// Snowflake refuses to drop a policy that is still attached; report where
+	// it is used rather than passing on the generic SQL error.
+	references, err := policyReferences(r.config, path)
+	if isNotFoundError(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Snowflake Masking Policy",
+			fmt.Sprintf("Could not list references of masking policy %s: %s", data.FullyQualifiedName.ValueString(), err),
+		)
+		return
+	}
+	if len(references) > 0 {
+		resp.Diagnostics.AddError(
+			"Snowflake Masking Policy Still In Use",
+			fmt.Sprintf("Masking policy %s is still attached to: %s. Remove the snowflake-ovh_masking_policy_attachment resources for these columns before destroying the policy.",
+				data.FullyQualifiedName.ValueString(), strings.Join(references, ", ")),
+		)
+		return
+	}
+
+	err = r.config.OVHClient.Delete(path, nil)
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Snowflake Masking Policy",
+			fmt.Sprintf("Could not delete masking policy %s: %s", data.ID.ValueString(), err),
+		)
+	}
+}
+
+func (r *SnowflakeMaskingPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = maskingPolicyIdentity.importID(ctx, r.config, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	importSchemaObject(ctx, r.config, "masking policy", "/cloud/project/snowflake/masking-policy", req, resp)
+}
+
+// read refreshes data from the OVH API after a create or update.
+func (r *SnowflakeMaskingPolicyResource) read(ctx context.Context, data *SnowflakeMaskingPolicyResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var policy map[string]interface{}
+	err := r.config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/masking-policy/%s", data.ID.ValueString()), &policy)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Snowflake Masking Policy",
+			fmt.Sprintf("Could not read masking policy %s: %s", data.ID.ValueString(), err),
+		)
+		return diags
+	}
+
+	return data.refresh(ctx, policy)
+}
+
+// refresh copies an OVH API masking policy into the model.
+func (m *SnowflakeMaskingPolicyResourceModel) refresh(ctx context.Context, policy map[string]interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.Name = apiString(policy, "name")
+	m.Database = apiString(policy, "database")
+	m.Schema = apiString(policy, "schema")
+	m.Body = SQLStatementValue{StringValue: apiString(policy, "body")}
+	m.ExemptOtherPolicies = apiBool(policy, "exemptOtherPolicies")
+	m.Comment = apiOptionalString(policy, "comment")
+	m.Owner = apiString(policy, "owner")
+	m.CreatedOn = apiString(policy, "createdOn")
+	m.FullyQualifiedName = types.StringValue(identifiers.NewSchemaObjectIdentifier(
+		m.Database.ValueString(), m.Schema.ValueString(), m.Name.ValueString()).FullyQualifiedName())
+
+	if returnType, ok := policy["returnType"].(string); ok && !dataTypeEqual(returnType, m.ReturnType.ValueString()) {
+		m.ReturnType = types.StringValue(returnType)
+	}
+
+	signature, d := flattenPolicySignature(ctx, policy["signature"], m.Signature)
+	diags.Append(d...)
+	m.Signature = signature
+
+	return diags
+}
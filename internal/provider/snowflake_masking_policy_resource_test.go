@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDataTypeEqual(t *testing.T) {
+	testCases := map[string]struct {
+		a, b     string
+		expected bool
+	}{
+		"identical":        {a: "VARCHAR", b: "VARCHAR", expected: true},
+		"default_length":   {a: "STRING", b: "VARCHAR(16777216)", expected: true},
+		"case":             {a: "number(38,0)", b: "NUMBER", expected: true},
+		"integer_synonym":  {a: "INTEGER", b: "NUMBER(38,0)", expected: true},
+		"different_family": {a: "VARCHAR", b: "NUMBER", expected: false},
+		"timestamp_kinds":  {a: "TIMESTAMP_LTZ", b: "TIMESTAMP_NTZ", expected: false},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := dataTypeEqual(tc.a, tc.b); got != tc.expected {
+				t.Errorf("expected %t for %q and %q, got %t", tc.expected, tc.a, tc.b, got)
+			}
+		})
+	}
+}
+
+func TestPolicySignatureEqual(t *testing.T) {
+	argument := func(name, dataType string) SnowflakePolicyArgumentModel {
+		return SnowflakePolicyArgumentModel{Name: types.StringValue(name), Type: types.StringValue(dataType)}
+	}
+
+	configured := []SnowflakePolicyArgumentModel{argument("val", "string"), argument("region", "string")}
+	reported := []SnowflakePolicyArgumentModel{argument("VAL", "VARCHAR(16777216)"), argument("REGION", "VARCHAR(16777216)")}
+
+	if !policySignatureEqual(configured, reported) {
+		t.Error("expected signatures to be equal")
+	}
+	if policySignatureEqual(configured, reported[:1]) {
+		t.Error("expected signatures with different arity to differ")
+	}
+	if policySignatureEqual(configured, []SnowflakePolicyArgumentModel{argument("val", "string"), argument("country", "string")}) {
+		t.Error("expected signatures with different argument names to differ")
+	}
+}
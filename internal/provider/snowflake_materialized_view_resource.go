@@ -0,0 +1,454 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/swcstudio/terraform-provider-ovh.snowflake/internal/sdk/identifiers"
+)
+
+var (
+	_ resource.Resource                = &SnowflakeMaterializedViewResource{}
+	_ resource.ResourceWithModifyPlan  = &SnowflakeMaterializedViewResource{}
+	_ resource.ResourceWithImportState = &SnowflakeMaterializedViewResource{}
+	_ resource.ResourceWithIdentity    = &SnowflakeMaterializedViewResource{}
+)
+
+var materializedViewIdentity = schemaObjectIdentity("materialized view")
+
+func NewSnowflakeMaterializedViewResource() resource.Resource {
+	return &SnowflakeMaterializedViewResource{}
+}
+
+type SnowflakeMaterializedViewResource struct {
+	config *Config
+}
+
+type SnowflakeMaterializedViewResourceModel struct {
+	ID                 types.String      `tfsdk:"id"`
+	Name               types.String      `tfsdk:"name"`
+	Database           types.String      `tfsdk:"database"`
+	Schema             types.String      `tfsdk:"schema"`
+	Statement          SQLStatementValue `tfsdk:"statement"`
+	Secure             types.Bool        `tfsdk:"secure"`
+	ChangeTracking     types.Bool        `tfsdk:"change_tracking"`
+	ClusterBy          types.List        `tfsdk:"cluster_by"`
+	CopyGrants         types.Bool        `tfsdk:"copy_grants"`
+	Columns            types.List        `tfsdk:"columns"`
+	Comment            types.String      `tfsdk:"comment"`
+	FullyQualifiedName types.String      `tfsdk:"fully_qualified_name"`
+	ReferencedObjects  types.List        `tfsdk:"referenced_objects"`
+	Owner              types.String      `tfsdk:"owner"`
+	CreatedOn          types.String      `tfsdk:"created_on"`
+	Invalid            types.Bool        `tfsdk:"invalid"`
+	InvalidReason      types.String      `tfsdk:"invalid_reason"`
+}
+
+func (r *SnowflakeMaterializedViewResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_materialized_view"
+}
+
+func (r *SnowflakeMaterializedViewResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Snowflake materialized view on OVH infrastructure.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Unique identifier for the materialized view.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the materialized view.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					identifiers.ValidName(),
+				},
+			},
+			"database": schema.StringAttribute{
+				Description: "Database that contains the materialized view.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					identifiers.ValidName(),
+				},
+			},
+			"schema": schema.StringAttribute{
+				Description: "Schema that contains the materialized view.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					identifiers.ValidName(),
+				},
+			},
+			"statement": schema.StringAttribute{
+				Description: "Query that defines the materialized view. Differences in whitespace, comments, keyword case and trailing semicolons are ignored.",
+				Required:    true,
+				CustomType:  SQLStatementType{},
+			},
+			"secure": schema.BoolAttribute{
+				Description: "Whether the materialized view is secure.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"change_tracking": schema.BoolAttribute{
+				Description: "Whether change tracking is enabled on the materialized view, which streams on it require.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"cluster_by": schema.ListAttribute{
+				Description: "Clustering key expressions of the materialized view.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"copy_grants": schema.BoolAttribute{
+				Description: "Whether to retain existing grants when the materialized view definition is replaced.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"columns": viewColumnsAttribute("materialized view"),
+			"comment": schema.StringAttribute{
+				Description: "Comment for the materialized view.",
+				Optional:    true,
+			},
+			"fully_qualified_name": schema.StringAttribute{
+				Description: "Fully qualified name of the materialized view.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"referenced_objects": schema.ListAttribute{
+				Description: "Tables and views the query reads from, as named in its FROM and JOIN clauses.",
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"owner": schema.StringAttribute{
+				Description: "Role that owns the materialized view.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_on": schema.StringAttribute{
+				Description: "Creation timestamp of the materialized view.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"invalid": schema.BoolAttribute{
+				Description: "Whether the materialized view is invalid, for example because its base table changed.",
+				Computed:    true,
+			},
+			"invalid_reason": schema.StringAttribute{
+				Description: "Reason the materialized view is invalid.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *SnowflakeMaterializedViewResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = materializedViewIdentity.schema()
+}
+
+func (r *SnowflakeMaterializedViewResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyViewPlan(ctx, req, resp)
+}
+
+func (r *SnowflakeMaterializedViewResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.config = config
+}
+
+func (r *SnowflakeMaterializedViewResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SnowflakeMaterializedViewResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating Snowflake materialized view", map[string]interface{}{
+		"name":     data.Name.ValueString(),
+		"database": data.Database.ValueString(),
+		"schema":   data.Schema.ValueString(),
+	})
+
+	columns, diags := expandViewColumns(ctx, data.Columns)
+	resp.Diagnostics.Append(diags...)
+
+	var clusterBy []types.String
+	resp.Diagnostics.Append(data.ClusterBy.ElementsAs(ctx, &clusterBy, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	viewConfig := map[string]interface{}{
+		"name":           data.Name.ValueString(),
+		"database":       data.Database.ValueString(),
+		"schema":         data.Schema.ValueString(),
+		"statement":      data.Statement.ValueString(),
+		"secure":         data.Secure.ValueBool(),
+		"changeTracking": data.ChangeTracking.ValueBool(),
+		"clusterBy":      stringValues(clusterBy),
+		"columns":        columns,
+		"comment":        data.Comment.ValueString(),
+	}
+
+	var result map[string]interface{}
+	err := r.config.OVHClient.Post("/cloud/project/snowflake/materialized-view", viewConfig, &result)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Snowflake Materialized View",
+			fmt.Sprintf("Could not create materialized view %s: %s", data.Name.ValueString(), err),
+		)
+		return
+	}
+
+	data.ID = apiString(result, "id")
+
+	resp.Diagnostics.Append(r.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Created Snowflake materialized view")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(materializedViewIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
+}
+
+func (r *SnowflakeMaterializedViewResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SnowflakeMaterializedViewResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Snowflake materialized view", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	var view map[string]interface{}
+	err := r.config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/materialized-view/%s", data.ID.ValueString()), &view)
+	if isNotFoundError(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Snowflake Materialized View",
+			fmt.Sprintf("Could not read materialized view %s: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(data.refresh(ctx, view)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(materializedViewIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
+}
+
+func (r *SnowflakeMaterializedViewResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state SnowflakeMaterializedViewResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating Snowflake materialized view", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	updateConfig := map[string]interface{}{}
+
+	// A new definition is applied with CREATE OR REPLACE, so only send it when
+	// the query itself changed rather than its formatting.
+	if !sqlEquivalent(data.Statement.ValueString(), state.Statement.ValueString()) {
+		updateConfig["statement"] = data.Statement.ValueString()
+		updateConfig["copyGrants"] = data.CopyGrants.ValueBool()
+	}
+	if !data.Secure.Equal(state.Secure) {
+		updateConfig["secure"] = data.Secure.ValueBool()
+	}
+	if !data.ChangeTracking.Equal(state.ChangeTracking) {
+		updateConfig["changeTracking"] = data.ChangeTracking.ValueBool()
+	}
+	if !data.ClusterBy.Equal(state.ClusterBy) {
+		var clusterBy []types.String
+		resp.Diagnostics.Append(data.ClusterBy.ElementsAs(ctx, &clusterBy, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		updateConfig["clusterBy"] = stringValues(clusterBy)
+	}
+	if !data.Columns.Equal(state.Columns) {
+		columns, diags := expandViewColumns(ctx, data.Columns)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		updateConfig["columns"] = columns
+	}
+	if !data.Comment.Equal(state.Comment) {
+		updateConfig["comment"] = data.Comment.ValueString()
+	}
+
+	if len(updateConfig) > 0 {
+		err := r.config.OVHClient.Put(fmt.Sprintf("/cloud/project/snowflake/materialized-view/%s", data.ID.ValueString()), updateConfig, nil)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Snowflake Materialized View",
+				fmt.Sprintf("Could not update materialized view %s: %s", data.ID.ValueString(), err),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(materializedViewIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
+}
+
+func (r *SnowflakeMaterializedViewResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SnowflakeMaterializedViewResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting Snowflake materialized view", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.config.OVHClient.Delete(fmt.Sprintf("/cloud/project/snowflake/materialized-view/%s", data.ID.ValueString()), nil)
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Snowflake Materialized View",
+			fmt.Sprintf("Could not delete materialized view %s: %s", data.ID.ValueString(), err),
+		)
+	}
+}
+
+func (r *SnowflakeMaterializedViewResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = materializedViewIdentity.importID(ctx, r.config, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	importSchemaObject(ctx, r.config, "materialized view", "/cloud/project/snowflake/materialized-view", req, resp)
+}
+
+// read refreshes data from the OVH API after a create or update.
+func (r *SnowflakeMaterializedViewResource) read(ctx context.Context, data *SnowflakeMaterializedViewResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var view map[string]interface{}
+	err := r.config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/materialized-view/%s", data.ID.ValueString()), &view)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Snowflake Materialized View",
+			fmt.Sprintf("Could not read materialized view %s: %s", data.ID.ValueString(), err),
+		)
+		return diags
+	}
+
+	return data.refresh(ctx, view)
+}
+
+// refresh copies an OVH API materialized view into the model, including
+// whether Snowflake has invalidated it. The statement and columns are read
+// back as for views.
+func (m *SnowflakeMaterializedViewResourceModel) refresh(ctx context.Context, view map[string]interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.Name = apiString(view, "name")
+	m.Database = apiString(view, "database")
+	m.Schema = apiString(view, "schema")
+	m.Secure = apiBool(view, "secure")
+	m.ChangeTracking = apiBool(view, "changeTracking")
+	m.Comment = apiOptionalString(view, "comment")
+	m.Owner = apiString(view, "owner")
+	m.CreatedOn = apiString(view, "createdOn")
+	m.Invalid = apiBool(view, "invalid")
+	m.InvalidReason = apiOptionalString(view, "invalidReason")
+
+	if clusterBy := apiStringList(view, "clusterBy"); len(clusterBy) > 0 {
+		value, d := types.ListValueFrom(ctx, types.StringType, clusterBy)
+		diags.Append(d...)
+		m.ClusterBy = value
+	} else {
+		m.ClusterBy = types.ListNull(types.StringType)
+	}
+	m.FullyQualifiedName = types.StringValue(identifiers.NewSchemaObjectIdentifier(
+		m.Database.ValueString(), m.Schema.ValueString(), m.Name.ValueString()).FullyQualifiedName())
+
+	// As for views, COPY GRANTS is not reported back.
+	if m.CopyGrants.IsNull() || m.CopyGrants.IsUnknown() {
+		m.CopyGrants = types.BoolValue(true)
+	}
+
+	if text, ok := view["text"].(string); ok && text != "" {
+		m.Statement = NewSQLStatementValue(viewQueryText(text))
+	}
+
+	columns, d := flattenViewColumns(ctx, view["columns"])
+	diags.Append(d...)
+	m.Columns = columns
+
+	references, d := referencedObjectsValue(ctx, m.Statement.ValueString())
+	diags.Append(d...)
+	m.ReferencedObjects = references
+
+	return diags
+}
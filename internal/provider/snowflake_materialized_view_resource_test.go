@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSnowflakeMaterializedViewResource_Refresh(t *testing.T) {
+	ctx := context.Background()
+
+	var m SnowflakeMaterializedViewResourceModel
+	diags := m.refresh(ctx, map[string]interface{}{
+		"name":           "DAILY_TOTALS",
+		"database":       "ANALYTICS",
+		"schema":         "PUBLIC",
+		"text":           "create materialized view DAILY_TOTALS as select day, sum(amount) from orders group by day;",
+		"secure":         true,
+		"changeTracking": true,
+		"clusterBy":      []interface{}{"day"},
+		"invalid":        true,
+		"invalidReason":  "Base table ORDERS dropped",
+		"columns": []interface{}{
+			map[string]interface{}{"name": "DAY", "comment": "Order day"},
+		},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if !m.ChangeTracking.ValueBool() || !m.Secure.ValueBool() {
+		t.Errorf("expected secure and change_tracking, got %s and %s", m.Secure, m.ChangeTracking)
+	}
+	if got := m.Statement.ValueString(); got != "select day, sum(amount) from orders group by day" {
+		t.Errorf("unexpected statement %q", got)
+	}
+	if len(m.ClusterBy.Elements()) != 1 {
+		t.Errorf("expected one clustering key, got %s", m.ClusterBy)
+	}
+	if !m.Invalid.ValueBool() || m.InvalidReason.ValueString() != "Base table ORDERS dropped" {
+		t.Errorf("expected the invalid reason to be read, got %s", m.InvalidReason)
+	}
+	if !m.CopyGrants.ValueBool() {
+		t.Error("expected copy_grants to default to true")
+	}
+	want := testViewColumns(t, SnowflakeViewColumnModel{Name: types.StringValue("DAY"), Comment: types.StringValue("Order day")})
+	if !m.Columns.Equal(want) {
+		t.Errorf("expected columns %s, got %s", want, m.Columns)
+	}
+	if m.FullyQualifiedName.ValueString() != "ANALYTICS.PUBLIC.DAILY_TOTALS" {
+		t.Errorf("unexpected fully qualified name %s", m.FullyQualifiedName)
+	}
+
+	// Without clustering keys cluster_by stays null rather than empty.
+	diags = m.refresh(ctx, map[string]interface{}{"name": "DAILY_TOTALS", "database": "ANALYTICS", "schema": "PUBLIC"})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !m.ClusterBy.IsNull() || m.ChangeTracking.ValueBool() {
+		t.Errorf("expected null cluster_by and change tracking off, got %s and %s", m.ClusterBy, m.ChangeTracking)
+	}
+}
@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/swcstudio/terraform-provider-ovh.snowflake/internal/sdk/identifiers"
+)
+
+// upstreamProviderType is the type of the upstream Snowflake provider, which
+// is published as snowflake-labs/snowflake and was formerly published as
+// chanzuckerberg/snowflake.
+const upstreamProviderType = "snowflake"
+
+// upstreamState holds the attributes of a resource of the upstream Snowflake
+// provider, decoded from its raw state.
+type upstreamState map[string]interface{}
+
+// upstreamStateMover returns a state mover that accepts resources of the given
+// upstream types and passes their state to move. move sets the target state
+// without an ID, since upstream resources identify objects by name only; Read
+// then looks the OVH ID up the way imports do. The identity is left for Read
+// to set as well, as the provider is not configured while moving state.
+func upstreamStateMover(sourceTypes []string, move func(ctx context.Context, sourceType string, source upstreamState, resp *resource.MoveStateResponse)) resource.StateMover {
+	return resource.StateMover{
+		StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+			if !isUpstreamProvider(req.SourceProviderAddress) || !slices.Contains(sourceTypes, req.SourceTypeName) {
+				return
+			}
+
+			tflog.Debug(ctx, "Moving state from the upstream Snowflake provider", map[string]interface{}{
+				"source_type": req.SourceTypeName,
+			})
+
+			if req.SourceRawState == nil || req.SourceRawState.JSON == nil {
+				resp.Diagnostics.AddError(
+					"Unable to Move Snowflake State",
+					fmt.Sprintf("The state of %s is not in JSON format. Refresh it with the upstream provider before moving it.", req.SourceTypeName),
+				)
+				return
+			}
+
+			decoder := json.NewDecoder(bytes.NewReader(req.SourceRawState.JSON))
+			decoder.UseNumber()
+			var source upstreamState
+			if err := decoder.Decode(&source); err != nil {
+				resp.Diagnostics.AddError(
+					"Unable to Move Snowflake State",
+					fmt.Sprintf("Could not decode the state of %s: %s", req.SourceTypeName, err),
+				)
+				return
+			}
+
+			move(ctx, req.SourceTypeName, source, resp)
+		},
+	}
+}
+
+// isUpstreamProvider reports whether address, such as
+// registry.terraform.io/snowflake-labs/snowflake, is the address of the
+// upstream Snowflake provider.
+func isUpstreamProvider(address string) bool {
+	return address == upstreamProviderType || strings.HasSuffix(address, "/"+upstreamProviderType)
+}
+
+// string returns the string stored under key, or null when it is empty.
+func (s upstreamState) string(key string) types.String {
+	return apiOptionalString(s, key)
+}
+
+// bool returns the boolean stored under key. Newer upstream versions store
+// booleans that default to Snowflake's value as the strings "true", "false"
+// and "default"; the latter is returned as null.
+func (s upstreamState) bool(key string) types.Bool {
+	switch v := s[key].(type) {
+	case bool:
+		return types.BoolValue(v)
+	case string:
+		switch strings.ToLower(v) {
+		case "true":
+			return types.BoolValue(true)
+		case "false":
+			return types.BoolValue(false)
+		}
+	}
+	return types.BoolNull()
+}
+
+// int64 returns the number stored under key. Newer upstream versions store -1
+// for numbers that default to Snowflake's value, which is returned as null.
+func (s upstreamState) int64(key string) types.Int64 {
+	v := apiInt64(s, key)
+	if v.ValueInt64() < 0 {
+		return types.Int64Null()
+	}
+	return v
+}
+
+// list returns the strings in the list or set stored under key.
+func (s upstreamState) list(key string) []string {
+	return stringValues(apiStringList(s, key))
+}
+
+// block returns the single nested block stored under key, or nil when the
+// block is absent.
+func (s upstreamState) block(key string) upstreamState {
+	items, ok := s[key].([]interface{})
+	if !ok || len(items) == 0 {
+		return nil
+	}
+	block, _ := items[0].(map[string]interface{})
+	return block
+}
+
+// upstreamObjectName converts a fully qualified name as the upstream provider
+// stores it, such as "ANALYTICS"."PUBLIC"."EVENTS", to the dotted form used
+// by this provider, quoting only the parts that need it.
+func upstreamObjectName(identifier string) (string, error) {
+	names, err := identifiers.ParseIdentifier(identifier)
+	if err != nil {
+		return "", err
+	}
+	for i, name := range names {
+		names[i] = identifiers.FormatName(name)
+	}
+	return strings.Join(names, "."), nil
+}
+
+// resolveMissingID sets id to the OVH ID of the object listed at endpoint that
+// matches the filters when id is null. Upstream resources identify objects by
+// name only, so state moved from the upstream Snowflake provider has no ID
+// until its first read, and neither has state upgraded from a placeholder ID.
+func resolveMissingID(config *Config, kind, endpoint string, filters map[string]string, id *types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !id.IsNull() {
+		return diags
+	}
+
+	resolved, err := lookupImportID(config, endpoint, filters)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Snowflake "+importTitle(kind),
+			fmt.Sprintf("Could not find %s by name: %s", kind, err),
+		)
+		return diags
+	}
+
+	*id = types.StringValue(resolved)
+	return diags
+}
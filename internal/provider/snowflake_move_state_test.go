@@ -0,0 +1,217 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const upstreamProviderAddress = "registry.terraform.io/snowflake-labs/snowflake"
+
+// moveState runs the state movers of r on the raw state of an upstream
+// resource, as Terraform does for a moved block, and returns the response of
+// the mover that handled it.
+func moveState(t *testing.T, r resource.Resource, sourceAddress, sourceType, rawState string) *resource.MoveStateResponse {
+	t.Helper()
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	for _, mover := range r.(resource.ResourceWithMoveState).MoveState(ctx) {
+		resp := &resource.MoveStateResponse{
+			TargetState: tfsdk.State{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			},
+		}
+		mover.StateMover(ctx, resource.MoveStateRequest{
+			SourceProviderAddress: sourceAddress,
+			SourceTypeName:        sourceType,
+			SourceRawState:        &tfprotov6.RawState{JSON: []byte(rawState)},
+		}, resp)
+		if resp.Diagnostics.HasError() || !resp.TargetState.Raw.IsNull() {
+			return resp
+		}
+	}
+	return nil
+}
+
+func TestSnowflakeWarehouseResource_MoveState(t *testing.T) {
+	tests := []struct {
+		name  string
+		state string
+		want  SnowflakeWarehouseResourceModel
+	}{
+		{
+			name: "v1",
+			state: `{"id": "ANALYTICS_WH", "name": "ANALYTICS_WH", "warehouse_size": "XSMALL", "auto_suspend": -1,
+				"auto_resume": "default", "initially_suspended": true, "comment": "", "max_cluster_count": 2}`,
+			want: SnowflakeWarehouseResourceModel{
+				Name:               types.StringValue("ANALYTICS_WH"),
+				Size:               types.StringValue("X-SMALL"),
+				MaxClusterCount:    types.Int64Value(2),
+				InitiallySuspended: types.BoolValue(true),
+			},
+		},
+		{
+			name: "v0",
+			state: `{"id": "ANALYTICS_WH", "name": "ANALYTICS_WH", "warehouse_size": "Medium", "auto_suspend": 120,
+				"auto_resume": false, "comment": "reporting"}`,
+			want: SnowflakeWarehouseResourceModel{
+				Name:        types.StringValue("ANALYTICS_WH"),
+				Size:        types.StringValue("MEDIUM"),
+				AutoSuspend: types.Int64Value(120),
+				AutoResume:  types.BoolValue(false),
+				Comment:     types.StringValue("reporting"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := moveState(t, NewSnowflakeWarehouseResource(), upstreamProviderAddress, "snowflake_warehouse", tt.state)
+			if resp == nil || resp.Diagnostics.HasError() {
+				t.Fatalf("state was not moved: %v", resp)
+			}
+
+			var got SnowflakeWarehouseResourceModel
+			resp.TargetState.Get(context.Background(), &got)
+			if got != tt.want {
+				t.Errorf("moved state = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSnowflakeUserResource_MoveState(t *testing.T) {
+	resp := moveState(t, NewSnowflakeUserResource(), upstreamProviderAddress, "snowflake_service_user",
+		`{"id": "ETL", "name": "ETL", "login_name": "ETL", "comment": "loader", "rsa_public_key": "MIIB"}`)
+	if resp == nil || resp.Diagnostics.HasError() {
+		t.Fatalf("state was not moved: %v", resp)
+	}
+
+	var got SnowflakeUserResourceModel
+	resp.TargetState.Get(context.Background(), &got)
+	want := SnowflakeUserResourceModel{
+		Name:         types.StringValue("ETL"),
+		Type:         types.StringValue("SERVICE"),
+		RSAPublicKey: types.StringValue("MIIB"),
+		Comment:      types.StringValue("loader"),
+	}
+	if got != want {
+		t.Errorf("moved state = %+v, want %+v", got, want)
+	}
+}
+
+func TestSnowflakeGrantResource_MoveState(t *testing.T) {
+	tests := []struct {
+		name       string
+		sourceType string
+		state      string
+		want       SnowflakeGrantResourceModel
+		wantErr    bool
+	}{
+		{
+			name:       "account object",
+			sourceType: "snowflake_grant_privileges_to_account_role",
+			state: `{"account_role_name": "ANALYST", "privileges": ["USAGE"], "all_privileges": false,
+				"on_account_object": [{"object_type": "WAREHOUSE", "object_name": "\"ANALYTICS_WH\""}]}`,
+			want: SnowflakeGrantResourceModel{
+				Privilege:  types.StringValue("USAGE"),
+				ObjectType: types.StringValue("WAREHOUSE"),
+				ObjectName: types.StringValue("ANALYTICS_WH"),
+				Role:       types.StringValue("ANALYST"),
+			},
+		},
+		{
+			name:       "schema object",
+			sourceType: "snowflake_grant_privileges_to_role",
+			state: `{"role_name": "ANALYST", "privileges": ["SELECT"],
+				"on_schema_object": [{"object_type": "TABLE", "object_name": "\"ANALYTICS\".\"PUBLIC\".\"Events\"", "all": [], "future": []}]}`,
+			want: SnowflakeGrantResourceModel{
+				Privilege:  types.StringValue("SELECT"),
+				ObjectType: types.StringValue("TABLE"),
+				ObjectName: types.StringValue(`ANALYTICS.PUBLIC."Events"`),
+				Role:       types.StringValue("ANALYST"),
+			},
+		},
+		{
+			name:       "schema",
+			sourceType: "snowflake_grant_privileges_to_account_role",
+			state: `{"account_role_name": "ANALYST", "privileges": ["USAGE"],
+				"on_schema": [{"schema_name": "\"ANALYTICS\".\"PUBLIC\"", "all_schemas_in_database": "", "future_schemas_in_database": ""}]}`,
+			want: SnowflakeGrantResourceModel{
+				Privilege:  types.StringValue("USAGE"),
+				ObjectType: types.StringValue("SCHEMA"),
+				ObjectName: types.StringValue("ANALYTICS.PUBLIC"),
+				Role:       types.StringValue("ANALYST"),
+			},
+		},
+		{
+			name:       "several privileges",
+			sourceType: "snowflake_grant_privileges_to_account_role",
+			state: `{"account_role_name": "ANALYST", "privileges": ["SELECT", "INSERT"],
+				"on_schema_object": [{"object_type": "TABLE", "object_name": "\"ANALYTICS\".\"PUBLIC\".\"EVENTS\""}]}`,
+			wantErr: true,
+		},
+		{
+			name:       "future objects",
+			sourceType: "snowflake_grant_privileges_to_account_role",
+			state: `{"account_role_name": "ANALYST", "privileges": ["SELECT"],
+				"on_schema_object": [{"object_type": "", "object_name": "", "future": [{"object_type_plural": "TABLES", "in_schema": "\"ANALYTICS\".\"PUBLIC\""}]}]}`,
+			wantErr: true,
+		},
+		{
+			name:       "account",
+			sourceType: "snowflake_grant_privileges_to_account_role",
+			state:      `{"account_role_name": "ANALYST", "privileges": ["CREATE DATABASE"], "on_account": true}`,
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := moveState(t, NewSnowflakeGrantResource(), upstreamProviderAddress, tt.sourceType, tt.state)
+			if resp == nil {
+				t.Fatal("no state mover handled the grant")
+			}
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Fatalf("errors = %v, wantErr %v", resp.Diagnostics, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			var got SnowflakeGrantResourceModel
+			resp.TargetState.Get(context.Background(), &got)
+			if got != tt.want {
+				t.Errorf("moved state = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMoveState_OtherSources(t *testing.T) {
+	tests := []struct {
+		name          string
+		sourceAddress string
+		sourceType    string
+	}{
+		{"other provider", "registry.terraform.io/hashicorp/null", "snowflake_warehouse"},
+		{"other resource", upstreamProviderAddress, "snowflake_database"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if resp := moveState(t, NewSnowflakeWarehouseResource(), tt.sourceAddress, tt.sourceType, `{"name": "X"}`); resp != nil {
+				t.Errorf("state was moved: %+v", resp)
+			}
+		})
+	}
+}
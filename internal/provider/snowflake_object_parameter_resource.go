@@ -0,0 +1,401 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/swcstudio/terraform-provider-ovh.snowflake/internal/sdk/identifiers"
+)
+
+var (
+	_ resource.Resource                   = &SnowflakeObjectParameterResource{}
+	_ resource.ResourceWithValidateConfig = &SnowflakeObjectParameterResource{}
+	_ resource.ResourceWithImportState    = &SnowflakeObjectParameterResource{}
+	_ resource.ResourceWithIdentity       = &SnowflakeObjectParameterResource{}
+)
+
+var objectParameterIdentity = resourceIdentity{
+	{name: "object_type", description: "Type of the object the parameter is set on, or SESSION."},
+	{name: "object_name", description: "Name of the object the parameter is set on, unset for SESSION.", optional: true},
+	{name: "key", description: "Name of the parameter."},
+}
+
+func NewSnowflakeObjectParameterResource() resource.Resource {
+	return &SnowflakeObjectParameterResource{}
+}
+
+type SnowflakeObjectParameterResource struct {
+	config *Config
+}
+
+type SnowflakeObjectParameterResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	ObjectType types.String `tfsdk:"object_type"`
+	ObjectName types.String `tfsdk:"object_name"`
+	Key        types.String `tfsdk:"key"`
+	Value      types.String `tfsdk:"value"`
+	Default    types.String `tfsdk:"default"`
+}
+
+// parameterObjectNameParts is the number of dot-separated parts expected in
+// object_name for each object type.
+var parameterObjectNameParts = map[string]int{
+	parameterLevelWarehouse: 1,
+	parameterLevelDatabase:  1,
+	parameterLevelUser:      1,
+	parameterLevelSchema:    2,
+	parameterLevelTable:     3,
+}
+
+func (r *SnowflakeObjectParameterResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_object_parameter"
+}
+
+func (r *SnowflakeObjectParameterResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Sets a Snowflake parameter on a warehouse, database, schema, table or user, or for the sessions the OVH service opens for the project. Destroying the resource unsets the parameter, so the object inherits the value from its parent again.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Unique identifier for the object parameter.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"object_type": schema.StringAttribute{
+				Description: fmt.Sprintf("Type of object the parameter is set on. One of: %s.", strings.Join(parameterObjectTypes, ", ")),
+				Required:    true,
+				Validators: []validator.String{
+					oneOfValidator{values: parameterObjectTypes},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"object_name": schema.StringAttribute{
+				Description: "Name of the object, qualified as database.schema for schemas and database.schema.table for tables. Must be omitted for SESSION.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key": schema.StringAttribute{
+				Description: "Name of the parameter. It must be supported at the chosen object type in the provider's parameter catalog.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.StringAttribute{
+				Description: "Value of the parameter. Booleans and numbers are given as strings, for example \"true\" or \"3600\".",
+				Required:    true,
+			},
+			"default": schema.StringAttribute{
+				Description: "Value the object inherits when the parameter is unset.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SnowflakeObjectParameterResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = objectParameterIdentity.schema()
+}
+
+func (r *SnowflakeObjectParameterResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data SnowflakeObjectParameterResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	level := ""
+	if !data.ObjectType.IsUnknown() {
+		level = data.ObjectType.ValueString()
+	}
+	resp.Diagnostics.Append(validateParameter(data.Key, data.Value, level)...)
+
+	if data.ObjectType.IsUnknown() || data.ObjectName.IsUnknown() {
+		return
+	}
+
+	if level == parameterLevelSession {
+		if !data.ObjectName.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("object_name"),
+				"Unexpected Object Name",
+				"object_name cannot be set when object_type is SESSION.",
+			)
+		}
+		return
+	}
+
+	parts, ok := parameterObjectNameParts[level]
+	if !ok {
+		return
+	}
+	if data.ObjectName.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("object_name"),
+			"Missing Object Name",
+			fmt.Sprintf("object_name is required when object_type is %s.", level),
+		)
+		return
+	}
+	if names, err := identifiers.ParseIdentifier(data.ObjectName.ValueString()); err != nil || len(names) != parts {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("object_name"),
+			"Invalid Object Name",
+			fmt.Sprintf("A %s name must be an identifier with %d dot-separated parts, got %q.", strings.ToLower(level), parts, data.ObjectName.ValueString()),
+		)
+	}
+}
+
+func (r *SnowflakeObjectParameterResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.config = config
+}
+
+func (r *SnowflakeObjectParameterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SnowflakeObjectParameterResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Setting Snowflake object parameter", map[string]interface{}{
+		"object_type": data.ObjectType.ValueString(),
+		"object_name": data.ObjectName.ValueString(),
+		"key":         data.Key.ValueString(),
+	})
+
+	data.ID = types.StringValue(data.id())
+
+	resp.Diagnostics.Append(r.set(&data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Set Snowflake object parameter")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(objectParameterIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
+}
+
+func (r *SnowflakeObjectParameterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SnowflakeObjectParameterResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Snowflake object parameter", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	var parameter map[string]interface{}
+	err := r.config.OVHClient.Get(data.endpoint(), &parameter)
+	if isNotFoundError(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Snowflake Object Parameter",
+			fmt.Sprintf("Could not read object parameter %s: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	// A parameter unset outside Terraform is inherited again, and the API
+	// reports the level it is inherited from.
+	if apiString(parameter, "level").ValueString() != data.ObjectType.ValueString() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.refresh(parameter)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(objectParameterIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
+}
+
+func (r *SnowflakeObjectParameterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SnowflakeObjectParameterResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating Snowflake object parameter", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	resp.Diagnostics.Append(r.set(&data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(objectParameterIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
+}
+
+func (r *SnowflakeObjectParameterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SnowflakeObjectParameterResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Unsetting Snowflake object parameter", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.config.OVHClient.Delete(data.endpoint(), nil)
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			"Error Unsetting Snowflake Object Parameter",
+			fmt.Sprintf("Could not unset object parameter %s: %s", data.ID.ValueString(), err),
+		)
+	}
+}
+
+// ImportState accepts IDs of the form object_type|object_name|key, or
+// SESSION|key for session parameters.
+func (r *SnowflakeObjectParameterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = objectParameterIdentity.importID(ctx, r.config, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parts, err := splitImportID(req.ID, "object_type|object_name|key or SESSION|key", 2, 3)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	data := SnowflakeObjectParameterResourceModel{
+		ObjectType: types.StringValue(strings.ToUpper(parts[0])),
+		ObjectName: types.StringNull(),
+		Key:        types.StringValue(strings.ToUpper(parts[len(parts)-1])),
+	}
+	if len(parts) == 3 {
+		data.ObjectName = types.StringValue(parts[1])
+	}
+
+	level := data.ObjectType.ValueString()
+	if !stringInSlice(level, parameterObjectTypes) || (level == parameterLevelSession) != data.ObjectName.IsNull() {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Import ID %q must have the form object_type|object_name|key, or SESSION|key for session parameters.", req.ID),
+		)
+		return
+	}
+	if p, ok := lookupParameter(data.Key.ValueString()); !ok || !p.allowedAt(level) {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("%q is not a parameter that can be set on a %s.", data.Key.ValueString(), strings.ToLower(level)),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(data.id())
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), data.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("object_type"), data.ObjectType)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("object_name"), data.ObjectName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key"), data.Key)...)
+}
+
+// set sends the planned value to the OVH API.
+func (r *SnowflakeObjectParameterResource) set(data *SnowflakeObjectParameterResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	err := r.config.OVHClient.Put(data.endpoint(), map[string]interface{}{
+		"value": data.Value.ValueString(),
+	}, nil)
+	if err != nil {
+		diags.AddError(
+			"Error Setting Snowflake Object Parameter",
+			fmt.Sprintf("Could not set object parameter %s: %s", data.ID.ValueString(), err),
+		)
+	}
+	return diags
+}
+
+// read refreshes data from the OVH API after a create or update.
+func (r *SnowflakeObjectParameterResource) read(ctx context.Context, data *SnowflakeObjectParameterResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var parameter map[string]interface{}
+	err := r.config.OVHClient.Get(data.endpoint(), &parameter)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Snowflake Object Parameter",
+			fmt.Sprintf("Could not read object parameter %s: %s", data.ID.ValueString(), err),
+		)
+		return diags
+	}
+
+	data.refresh(parameter)
+	return diags
+}
+
+// endpoint returns the OVH API path of the parameter.
+func (m *SnowflakeObjectParameterResourceModel) endpoint() string {
+	return parameterEndpoint(m.ObjectType.ValueString(), m.ObjectName.ValueString(), m.Key.ValueString())
+}
+
+// id returns the resource ID, built from the object type, name and key.
+func (m *SnowflakeObjectParameterResourceModel) id() string {
+	if m.ObjectName.IsNull() {
+		return fmt.Sprintf("parameter-%s-%s", strings.ToLower(m.ObjectType.ValueString()), strings.ToUpper(m.Key.ValueString()))
+	}
+	return fmt.Sprintf("parameter-%s-%s-%s", strings.ToLower(m.ObjectType.ValueString()), m.ObjectName.ValueString(), strings.ToUpper(m.Key.ValueString()))
+}
+
+// refresh copies an OVH API parameter into the model.
+func (m *SnowflakeObjectParameterResourceModel) refresh(parameter map[string]interface{}) {
+	m.Value = refreshParameterValue(m.Key.ValueString(), m.Value, apiString(parameter, "value").ValueString())
+	m.Default = apiString(parameter, "default")
+}
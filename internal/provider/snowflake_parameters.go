@@ -0,0 +1,252 @@
+package provider
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Levels at which a Snowflake parameter can be set. SESSION covers the
+// sessions the OVH service opens on behalf of the project.
+const (
+	parameterLevelAccount   = "ACCOUNT"
+	parameterLevelWarehouse = "WAREHOUSE"
+	parameterLevelDatabase  = "DATABASE"
+	parameterLevelSchema    = "SCHEMA"
+	parameterLevelTable     = "TABLE"
+	parameterLevelUser      = "USER"
+	parameterLevelSession   = "SESSION"
+)
+
+// parameterObjectTypes are the levels snowflake_object_parameter can target.
+var parameterObjectTypes = []string{
+	parameterLevelWarehouse, parameterLevelDatabase, parameterLevelSchema,
+	parameterLevelTable, parameterLevelUser, parameterLevelSession,
+}
+
+// parameterType is the value type of a Snowflake parameter.
+type parameterType string
+
+const (
+	parameterTypeBoolean parameterType = "BOOLEAN"
+	parameterTypeNumber  parameterType = "NUMBER"
+	parameterTypeString  parameterType = "STRING"
+	parameterTypeEnum    parameterType = "ENUM"
+)
+
+// snowflakeParameter describes a parameter in the built-in catalog.
+type snowflakeParameter struct {
+	Type    parameterType
+	Default string
+	Levels  []string
+	// Min and Max bound NUMBER parameters.
+	Min, Max int64
+	// Values lists the accepted values of ENUM parameters.
+	Values []string
+	// MaxLength bounds STRING parameters when non-zero.
+	MaxLength int
+}
+
+var (
+	sessionLevels   = []string{parameterLevelAccount, parameterLevelUser, parameterLevelSession}
+	retentionLevels = []string{parameterLevelAccount, parameterLevelDatabase, parameterLevelSchema, parameterLevelTable}
+	loggingLevels   = []string{parameterLevelAccount, parameterLevelDatabase, parameterLevelSchema, parameterLevelUser, parameterLevelSession}
+	taskLevels      = []string{parameterLevelAccount, parameterLevelDatabase, parameterLevelSchema}
+)
+
+// parameterCatalog lists the parameters the provider knows how to validate,
+// keyed by upper-case name.
+var parameterCatalog = map[string]snowflakeParameter{
+	"ABORT_DETACHED_QUERY": {Type: parameterTypeBoolean, Default: "false", Levels: sessionLevels},
+	"AUTOCOMMIT":           {Type: parameterTypeBoolean, Default: "true", Levels: sessionLevels},
+	"CLIENT_SESSION_KEEP_ALIVE": {
+		Type: parameterTypeBoolean, Default: "false", Levels: sessionLevels,
+	},
+	"DATA_RETENTION_TIME_IN_DAYS": {
+		Type: parameterTypeNumber, Default: "1", Levels: retentionLevels, Min: 0, Max: 90,
+	},
+	"DEFAULT_DDL_COLLATION": {Type: parameterTypeString, Default: "", Levels: retentionLevels},
+	"ENABLE_UNREDACTED_QUERY_SYNTAX_ERROR": {
+		Type: parameterTypeBoolean, Default: "false", Levels: []string{parameterLevelAccount, parameterLevelUser},
+	},
+	"LOG_LEVEL": {
+		Type: parameterTypeEnum, Default: "OFF", Levels: loggingLevels,
+		Values: []string{"TRACE", "DEBUG", "INFO", "WARN", "ERROR", "FATAL", "OFF"},
+	},
+	"MAX_CONCURRENCY_LEVEL": {
+		Type: parameterTypeNumber, Default: "8", Levels: []string{parameterLevelAccount, parameterLevelWarehouse}, Min: 1, Max: 128,
+	},
+	"MAX_DATA_EXTENSION_TIME_IN_DAYS": {
+		Type: parameterTypeNumber, Default: "14", Levels: retentionLevels, Min: 0, Max: 90,
+	},
+	"MIN_DATA_RETENTION_TIME_IN_DAYS": {
+		Type: parameterTypeNumber, Default: "0", Levels: []string{parameterLevelAccount}, Min: 0, Max: 90,
+	},
+	"NETWORK_POLICY": {
+		Type: parameterTypeString, Default: "", Levels: []string{parameterLevelAccount, parameterLevelUser},
+	},
+	"PERIODIC_DATA_REKEYING": {
+		Type: parameterTypeBoolean, Default: "false", Levels: []string{parameterLevelAccount},
+	},
+	"QUERY_TAG": {Type: parameterTypeString, Default: "", Levels: sessionLevels, MaxLength: 2000},
+	"STATEMENT_QUEUED_TIMEOUT_IN_SECONDS": {
+		Type: parameterTypeNumber, Default: "0", Min: 0, Max: 604800,
+		Levels: []string{parameterLevelAccount, parameterLevelWarehouse, parameterLevelUser, parameterLevelSession},
+	},
+	"STATEMENT_TIMEOUT_IN_SECONDS": {
+		Type: parameterTypeNumber, Default: "172800", Min: 0, Max: 604800,
+		Levels: []string{parameterLevelAccount, parameterLevelWarehouse, parameterLevelUser, parameterLevelSession},
+	},
+	"SUSPEND_TASK_AFTER_NUM_FAILURES": {
+		Type: parameterTypeNumber, Default: "10", Levels: taskLevels, Min: 0, Max: 1000000,
+	},
+	"TIMESTAMP_TYPE_MAPPING": {
+		Type: parameterTypeEnum, Default: "TIMESTAMP_NTZ", Levels: sessionLevels,
+		Values: []string{"TIMESTAMP_LTZ", "TIMESTAMP_NTZ", "TIMESTAMP_TZ"},
+	},
+	"TIMEZONE": {Type: parameterTypeString, Default: "America/Los_Angeles", Levels: sessionLevels},
+	"TRACE_LEVEL": {
+		Type: parameterTypeEnum, Default: "OFF", Levels: loggingLevels,
+		Values: []string{"ALWAYS", "ON_EVENT", "OFF"},
+	},
+	"USE_CACHED_RESULT": {Type: parameterTypeBoolean, Default: "true", Levels: sessionLevels},
+	"USER_TASK_TIMEOUT_MS": {
+		Type: parameterTypeNumber, Default: "3600000", Levels: taskLevels, Min: 0, Max: 86400000,
+	},
+	"WEEK_START": {Type: parameterTypeNumber, Default: "0", Levels: sessionLevels, Min: 0, Max: 7},
+}
+
+// lookupParameter returns the catalog entry for key, which is matched
+// case-insensitively.
+func lookupParameter(key string) (snowflakeParameter, bool) {
+	p, ok := parameterCatalog[strings.ToUpper(key)]
+	return p, ok
+}
+
+// parameterKeys returns the catalog keys that can be set at level, sorted.
+func parameterKeys(level string) []string {
+	var keys []string
+	for key, p := range parameterCatalog {
+		if p.allowedAt(level) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (p snowflakeParameter) allowedAt(level string) bool {
+	return stringInSlice(level, p.Levels)
+}
+
+// validate checks value against the parameter type.
+func (p snowflakeParameter) validate(value string) error {
+	switch p.Type {
+	case parameterTypeBoolean:
+		if _, err := strconv.ParseBool(value); err != nil {
+			return fmt.Errorf("expected TRUE or FALSE, got %q", value)
+		}
+	case parameterTypeNumber:
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return fmt.Errorf("expected an integer, got %q", value)
+		}
+		if n < p.Min || n > p.Max {
+			return fmt.Errorf("expected a value between %d and %d, got %d", p.Min, p.Max, n)
+		}
+	case parameterTypeEnum:
+		if !stringInSlice(strings.ToUpper(value), p.Values) {
+			return fmt.Errorf("expected one of %s, got %q", strings.Join(p.Values, ", "), value)
+		}
+	case parameterTypeString:
+		if p.MaxLength > 0 && len(value) > p.MaxLength {
+			return fmt.Errorf("expected at most %d characters, got %d", p.MaxLength, len(value))
+		}
+	}
+	return nil
+}
+
+// normalize returns the canonical spelling of value, as Snowflake reports it.
+func (p snowflakeParameter) normalize(value string) string {
+	switch p.Type {
+	case parameterTypeBoolean:
+		if b, err := strconv.ParseBool(value); err == nil {
+			return strconv.FormatBool(b)
+		}
+	case parameterTypeNumber:
+		if n, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return strconv.FormatInt(n, 10)
+		}
+	case parameterTypeEnum:
+		return strings.ToUpper(value)
+	}
+	return value
+}
+
+// validateParameter reports configuration errors for setting key to value at
+// level. Unknown values are skipped.
+func validateParameter(key, value types.String, level string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if key.IsUnknown() || key.IsNull() {
+		return diags
+	}
+
+	p, ok := lookupParameter(key.ValueString())
+	if !ok {
+		diags.AddAttributeError(
+			path.Root("key"),
+			"Unknown Parameter",
+			fmt.Sprintf("Parameter %q is not in the provider's parameter catalog.", key.ValueString()),
+		)
+		return diags
+	}
+
+	if level != "" && !p.allowedAt(level) {
+		diags.AddAttributeError(
+			path.Root("key"),
+			"Parameter Not Supported At This Level",
+			fmt.Sprintf("Parameter %s cannot be set at %s level. Supported levels: %s.",
+				strings.ToUpper(key.ValueString()), level, strings.Join(p.Levels, ", ")),
+		)
+	}
+
+	if value.IsUnknown() || value.IsNull() {
+		return diags
+	}
+	if err := p.validate(value.ValueString()); err != nil {
+		diags.AddAttributeError(
+			path.Root("value"),
+			"Invalid Parameter Value",
+			fmt.Sprintf("Invalid value for parameter %s: %s.", strings.ToUpper(key.ValueString()), err),
+		)
+	}
+
+	return diags
+}
+
+// refreshParameterValue returns the value reported by the API, keeping the
+// configured spelling when the two are equivalent.
+func refreshParameterValue(key string, configured types.String, reported string) types.String {
+	p, ok := lookupParameter(key)
+	if ok && !configured.IsNull() && !configured.IsUnknown() && p.normalize(configured.ValueString()) == p.normalize(reported) {
+		return configured
+	}
+	return types.StringValue(reported)
+}
+
+// parameterEndpoint returns the OVH API path of a parameter at level. The
+// object name is passed as a query parameter for object levels.
+func parameterEndpoint(level, objectName, key string) string {
+	endpoint := fmt.Sprintf("/cloud/project/snowflake/parameter/%s/%s", strings.ToLower(level), strings.ToUpper(key))
+	if objectName != "" {
+		endpoint += "?objectName=" + url.QueryEscape(objectName)
+	}
+	return endpoint
+}
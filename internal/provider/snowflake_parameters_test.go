@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSnowflakeParameterValidate(t *testing.T) {
+	tests := []struct {
+		key     string
+		value   string
+		wantErr bool
+	}{
+		{"STATEMENT_TIMEOUT_IN_SECONDS", "3600", false},
+		{"STATEMENT_TIMEOUT_IN_SECONDS", "-1", true},
+		{"STATEMENT_TIMEOUT_IN_SECONDS", "1h", true},
+		{"DATA_RETENTION_TIME_IN_DAYS", "90", false},
+		{"DATA_RETENTION_TIME_IN_DAYS", "91", true},
+		{"AUTOCOMMIT", "FALSE", false},
+		{"AUTOCOMMIT", "no", true},
+		{"LOG_LEVEL", "warn", false},
+		{"LOG_LEVEL", "VERBOSE", true},
+		{"TIMEZONE", "Europe/Paris", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key+"="+tt.value, func(t *testing.T) {
+			p, ok := lookupParameter(tt.key)
+			if !ok {
+				t.Fatalf("parameter %s missing from catalog", tt.key)
+			}
+			err := p.validate(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validate(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateParameterLevel(t *testing.T) {
+	tests := []struct {
+		name    string
+		key     string
+		level   string
+		wantErr bool
+	}{
+		{"account timeout", "statement_timeout_in_seconds", parameterLevelAccount, false},
+		{"warehouse concurrency", "MAX_CONCURRENCY_LEVEL", parameterLevelWarehouse, false},
+		{"warehouse timezone", "TIMEZONE", parameterLevelWarehouse, true},
+		{"table retention", "DATA_RETENTION_TIME_IN_DAYS", parameterLevelTable, false},
+		{"unknown key", "NOT_A_PARAMETER", parameterLevelAccount, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validateParameter(types.StringValue(tt.key), types.StringUnknown(), tt.level)
+			if diags.HasError() != tt.wantErr {
+				t.Errorf("validateParameter(%s, %s) errors = %v, wantErr %v", tt.key, tt.level, diags, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRefreshParameterValue(t *testing.T) {
+	if got := refreshParameterValue("AUTOCOMMIT", types.StringValue("TRUE"), "true"); got.ValueString() != "TRUE" {
+		t.Errorf("equivalent boolean should keep configured spelling, got %s", got)
+	}
+	if got := refreshParameterValue("AUTOCOMMIT", types.StringValue("TRUE"), "false"); got.ValueString() != "false" {
+		t.Errorf("changed value should be reported, got %s", got)
+	}
+}
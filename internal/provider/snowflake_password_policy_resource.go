@@ -0,0 +1,301 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = &SnowflakePasswordPolicyResource{}
+	_ resource.ResourceWithValidateConfig = &SnowflakePasswordPolicyResource{}
+	_ resource.ResourceWithImportState    = &SnowflakePasswordPolicyResource{}
+	_ resource.ResourceWithIdentity       = &SnowflakePasswordPolicyResource{}
+)
+
+var passwordPolicyIdentity = schemaObjectIdentity("password policy")
+
+func NewSnowflakePasswordPolicyResource() resource.Resource {
+	return &SnowflakePasswordPolicyResource{}
+}
+
+type SnowflakePasswordPolicyResource struct {
+	config *Config
+}
+
+type SnowflakePasswordPolicyResourceModel struct {
+	SnowflakeSecurityPolicyResourceModel
+
+	MinLength         types.Int64 `tfsdk:"min_length"`
+	MaxLength         types.Int64 `tfsdk:"max_length"`
+	MinUpperCaseChars types.Int64 `tfsdk:"min_upper_case_chars"`
+	MinLowerCaseChars types.Int64 `tfsdk:"min_lower_case_chars"`
+	MinNumericChars   types.Int64 `tfsdk:"min_numeric_chars"`
+	MinSpecialChars   types.Int64 `tfsdk:"min_special_chars"`
+	MinAgeDays        types.Int64 `tfsdk:"min_age_days"`
+	MaxAgeDays        types.Int64 `tfsdk:"max_age_days"`
+	MaxRetries        types.Int64 `tfsdk:"max_retries"`
+	LockoutTimeMins   types.Int64 `tfsdk:"lockout_time_mins"`
+	History           types.Int64 `tfsdk:"history"`
+}
+
+func (r *SnowflakePasswordPolicyResource) api() securityPolicyAPI {
+	return securityPolicyAPI{
+		config:   r.config,
+		endpoint: "/cloud/project/snowflake/password-policy",
+		kind:     "password policy",
+		title:    "Password Policy",
+	}
+}
+
+func (r *SnowflakePasswordPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_password_policy"
+}
+
+func (r *SnowflakePasswordPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := securityPolicyAttributes("password policy")
+
+	attributes["min_length"] = policyInt64Attribute("Minimum number of characters in a password.", 14, 8, 256)
+	attributes["max_length"] = policyInt64Attribute("Maximum number of characters in a password.", 256, 8, 256)
+	attributes["min_upper_case_chars"] = policyInt64Attribute("Minimum number of upper-case characters.", 1, 0, 256)
+	attributes["min_lower_case_chars"] = policyInt64Attribute("Minimum number of lower-case characters.", 1, 0, 256)
+	attributes["min_numeric_chars"] = policyInt64Attribute("Minimum number of numeric characters.", 1, 0, 256)
+	attributes["min_special_chars"] = policyInt64Attribute("Minimum number of special characters.", 0, 0, 256)
+	attributes["min_age_days"] = policyInt64Attribute("Days a password must be kept before it can be changed again.", 0, 0, 999)
+	attributes["max_age_days"] = policyInt64Attribute("Days after which a password must be changed; 0 disables expiry.", 90, 0, 999)
+	attributes["max_retries"] = policyInt64Attribute("Failed login attempts before the user is locked out.", 5, 1, 10)
+	attributes["lockout_time_mins"] = policyInt64Attribute("Minutes a user stays locked out after too many failed attempts.", 15, 1, 999)
+	attributes["history"] = policyInt64Attribute("Number of previous passwords that cannot be reused.", 0, 0, 24)
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a Snowflake password policy on OVH infrastructure, enforcing password complexity, expiry and lockout. Attach it to the account or to users with snowflake-ovh_security_policy_attachment.",
+		Attributes:  attributes,
+	}
+}
+
+func (r *SnowflakePasswordPolicyResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = passwordPolicyIdentity.schema()
+}
+
+func (r *SnowflakePasswordPolicyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data SnowflakePasswordPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Unset values take their defaults, which are consistent with each other.
+	value := func(v types.Int64, def int64) (int64, bool) {
+		if v.IsUnknown() {
+			return 0, false
+		}
+		if v.IsNull() {
+			return def, true
+		}
+		return v.ValueInt64(), true
+	}
+
+	minLength, minKnown := value(data.MinLength, 14)
+	maxLength, maxKnown := value(data.MaxLength, 256)
+	if minKnown && maxKnown && minLength > maxLength {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("min_length"),
+			"Invalid Password Length",
+			fmt.Sprintf("min_length (%d) cannot be greater than max_length (%d).", minLength, maxLength),
+		)
+	}
+
+	required := int64(0)
+	allKnown := maxKnown
+	for _, field := range []struct {
+		v   types.Int64
+		def int64
+	}{
+		{data.MinUpperCaseChars, 1},
+		{data.MinLowerCaseChars, 1},
+		{data.MinNumericChars, 1},
+		{data.MinSpecialChars, 0},
+	} {
+		n, known := value(field.v, field.def)
+		required += n
+		allKnown = allKnown && known
+	}
+	if allKnown && required > maxLength {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_length"),
+			"Unsatisfiable Password Policy",
+			fmt.Sprintf("The minimum character counts add up to %d, more than max_length (%d).", required, maxLength),
+		)
+	}
+
+	minAge, minAgeKnown := value(data.MinAgeDays, 0)
+	maxAge, maxAgeKnown := value(data.MaxAgeDays, 90)
+	if minAgeKnown && maxAgeKnown && maxAge != 0 && minAge > maxAge {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("min_age_days"),
+			"Invalid Password Age",
+			fmt.Sprintf("min_age_days (%d) cannot be greater than max_age_days (%d).", minAge, maxAge),
+		)
+	}
+}
+
+func (r *SnowflakePasswordPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.config = config
+}
+
+func (r *SnowflakePasswordPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SnowflakePasswordPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.api().create(ctx, &data.SnowflakeSecurityPolicyResourceModel, data.settings())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, diags := r.api().read(&data.SnowflakeSecurityPolicyResourceModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.refresh(policy)
+
+	tflog.Trace(ctx, "Created Snowflake password policy")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(passwordPolicyIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
+}
+
+func (r *SnowflakePasswordPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SnowflakePasswordPolicyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Snowflake password policy", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	policy, err := r.api().get(&data.SnowflakeSecurityPolicyResourceModel)
+	if isNotFoundError(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Snowflake Password Policy",
+			fmt.Sprintf("Could not read password policy %s: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	data.SnowflakeSecurityPolicyResourceModel.refresh(policy)
+	data.refresh(policy)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(passwordPolicyIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
+}
+
+func (r *SnowflakePasswordPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state SnowflakePasswordPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.api().update(ctx, &data.SnowflakeSecurityPolicyResourceModel, &state.SnowflakeSecurityPolicyResourceModel,
+		data.settings(), state.settings())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, diags := r.api().read(&data.SnowflakeSecurityPolicyResourceModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.refresh(policy)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(passwordPolicyIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
+}
+
+func (r *SnowflakePasswordPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SnowflakePasswordPolicyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.api().delete(ctx, &data.SnowflakeSecurityPolicyResourceModel)...)
+}
+
+func (r *SnowflakePasswordPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = passwordPolicyIdentity.importID(ctx, r.config, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	importSchemaObject(ctx, r.config, r.api().kind, r.api().endpoint, req, resp)
+}
+
+// fields maps OVH API keys to the password policy settings.
+func (m *SnowflakePasswordPolicyResourceModel) fields() map[string]*types.Int64 {
+	return map[string]*types.Int64{
+		"passwordMinLength":         &m.MinLength,
+		"passwordMaxLength":         &m.MaxLength,
+		"passwordMinUpperCaseChars": &m.MinUpperCaseChars,
+		"passwordMinLowerCaseChars": &m.MinLowerCaseChars,
+		"passwordMinNumericChars":   &m.MinNumericChars,
+		"passwordMinSpecialChars":   &m.MinSpecialChars,
+		"passwordMinAgeDays":        &m.MinAgeDays,
+		"passwordMaxAgeDays":        &m.MaxAgeDays,
+		"passwordMaxRetries":        &m.MaxRetries,
+		"passwordLockoutTimeMins":   &m.LockoutTimeMins,
+		"passwordHistory":           &m.History,
+	}
+}
+
+// settings returns the password policy settings for the OVH API.
+func (m *SnowflakePasswordPolicyResourceModel) settings() map[string]interface{} {
+	settings := map[string]interface{}{}
+	for key, value := range m.fields() {
+		settings[key] = value.ValueInt64()
+	}
+	return settings
+}
+
+// refresh copies the password policy settings of an OVH API policy into the
+// model.
+func (m *SnowflakePasswordPolicyResourceModel) refresh(policy map[string]interface{}) {
+	for key, value := range m.fields() {
+		*value = apiInt64(policy, key)
+	}
+}
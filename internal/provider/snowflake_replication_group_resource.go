@@ -0,0 +1,594 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/swcstudio/terraform-provider-ovh.snowflake/internal/sdk/identifiers"
+)
+
+var (
+	_ resource.Resource                   = &SnowflakeReplicationGroupResource{}
+	_ resource.ResourceWithValidateConfig = &SnowflakeReplicationGroupResource{}
+	_ resource.ResourceWithImportState    = &SnowflakeReplicationGroupResource{}
+	_ resource.ResourceWithIdentity       = &SnowflakeReplicationGroupResource{}
+)
+
+var replicationGroupIdentity = accountObjectIdentity("replication group")
+
+func NewSnowflakeReplicationGroupResource() resource.Resource {
+	return &SnowflakeReplicationGroupResource{}
+}
+
+type SnowflakeReplicationGroupResource struct {
+	config *Config
+}
+
+// SnowflakeReplicationGroupResourceModel is shared by replication and
+// failover groups; failover groups embed it.
+type SnowflakeReplicationGroupResourceModel struct {
+	ID                      types.String `tfsdk:"id"`
+	Name                    types.String `tfsdk:"name"`
+	ReplicaOf               types.String `tfsdk:"replica_of"`
+	ObjectTypes             types.Set    `tfsdk:"object_types"`
+	AllowedDatabases        types.Set    `tfsdk:"allowed_databases"`
+	AllowedShares           types.Set    `tfsdk:"allowed_shares"`
+	AllowedIntegrationTypes types.Set    `tfsdk:"allowed_integration_types"`
+	AllowedAccounts         types.Set    `tfsdk:"allowed_accounts"`
+	ReplicationSchedule     types.String `tfsdk:"replication_schedule"`
+	IsPrimary               types.Bool   `tfsdk:"is_primary"`
+	PrimaryAccount          types.String `tfsdk:"primary_account"`
+	Region                  types.String `tfsdk:"region"`
+	NextScheduledRefresh    types.String `tfsdk:"next_scheduled_refresh"`
+	Owner                   types.String `tfsdk:"owner"`
+	CreatedOn               types.String `tfsdk:"created_on"`
+}
+
+// replicationObjectTypes are the object types a replication or failover
+// group can replicate.
+var replicationObjectTypes = []string{
+	"ACCOUNT PARAMETERS", "DATABASES", "INTEGRATIONS", "NETWORK POLICIES",
+	"RESOURCE MONITORS", "ROLES", "SHARES", "USERS", "WAREHOUSES",
+}
+
+// replicationIntegrationTypes are the integration types that can be
+// replicated when INTEGRATIONS is among the object types.
+var replicationIntegrationTypes = []string{
+	"SECURITY INTEGRATIONS", "API INTEGRATIONS", "STORAGE INTEGRATIONS",
+	"EXTERNAL ACCESS INTEGRATIONS", "NOTIFICATION INTEGRATIONS",
+}
+
+// replicationSchedulePattern matches "<n> MINUTE" or "USING CRON <expr> <tz>".
+var replicationSchedulePattern = regexp.MustCompile(`(?i)^\s*(\d+\s+MINUTES?|USING\s+CRON\s+\S+(\s+\S+){4}\s+\S+)\s*$`)
+
+type replicationScheduleValidator struct{}
+
+func (v replicationScheduleValidator) Description(ctx context.Context) string {
+	return "value must be \"<n> MINUTE\" or \"USING CRON <expression> <time zone>\""
+}
+
+func (v replicationScheduleValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v replicationScheduleValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if !replicationSchedulePattern.MatchString(req.ConfigValue.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Replication Schedule",
+			fmt.Sprintf("%q is not a valid replication schedule: %s.", req.ConfigValue.ValueString(), v.Description(ctx)),
+		)
+	}
+}
+
+// setOneOfValidator checks that every element of a string set is one of a
+// fixed list of values.
+type setOneOfValidator struct {
+	values []string
+}
+
+func (v setOneOfValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("each value must be one of: %s", strings.Join(v.values, ", "))
+}
+
+func (v setOneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v setOneOfValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for _, element := range req.ConfigValue.Elements() {
+		value, ok := element.(types.String)
+		if !ok || value.IsNull() || value.IsUnknown() {
+			continue
+		}
+		if !stringInSlice(value.ValueString(), v.values) {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Invalid Attribute Value",
+				fmt.Sprintf("%q is not valid: %s.", value.ValueString(), v.Description(ctx)),
+			)
+		}
+	}
+}
+
+// replicationGroupAttributes returns the schema attributes shared by
+// replication and failover groups. kind is "replication group" or
+// "failover group".
+func replicationGroupAttributes(kind string) map[string]schema.Attribute {
+	primaryOnlySet := func(description string, validators ...validator.Set) schema.SetAttribute {
+		return schema.SetAttribute{
+			Description: description + " Set on the primary group only; secondaries report the primary's value.",
+			ElementType: types.StringType,
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.Set{
+				setplanmodifier.UseStateForUnknown(),
+			},
+			Validators: validators,
+		}
+	}
+	computedString := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			Description: description,
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+
+	return map[string]schema.Attribute{
+		"id": computedString(fmt.Sprintf("Unique identifier for the %s.", kind)),
+		"name": schema.StringAttribute{
+			Description: fmt.Sprintf("Name of the %s.", kind),
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				identifiers.ValidName(),
+			},
+		},
+		"replica_of": schema.StringAttribute{
+			Description: fmt.Sprintf("Primary %s to create this group as a secondary of, as organization.account.group. Omit to create a primary group.", kind),
+			Optional:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"object_types": primaryOnlySet(
+			fmt.Sprintf("Object types to replicate (%s).", strings.Join(replicationObjectTypes, ", ")),
+			setOneOfValidator{values: replicationObjectTypes},
+		),
+		"allowed_databases": primaryOnlySet("Databases to replicate. Requires DATABASES in object_types."),
+		"allowed_shares":    primaryOnlySet("Shares to replicate. Requires SHARES in object_types."),
+		"allowed_integration_types": primaryOnlySet(
+			fmt.Sprintf("Integration types to replicate (%s). Requires INTEGRATIONS in object_types.", strings.Join(replicationIntegrationTypes, ", ")),
+			setOneOfValidator{values: replicationIntegrationTypes},
+		),
+		"allowed_accounts": primaryOnlySet("Target accounts, possibly in other OVH regions, that may hold secondaries of the group, as organization.account identifiers."),
+		"replication_schedule": schema.StringAttribute{
+			Description: "Automatic refresh schedule of secondaries, such as \"10 MINUTE\" or \"USING CRON 0 * * * * UTC\".",
+			Optional:    true,
+			Validators: []validator.String{
+				replicationScheduleValidator{},
+			},
+		},
+		"is_primary": schema.BoolAttribute{
+			Description: fmt.Sprintf("Whether this is the primary %s.", kind),
+			Computed:    true,
+		},
+		"primary_account":        computedString("Account that holds the primary group."),
+		"region":                 computedString("OVH region of the account that holds this group."),
+		"next_scheduled_refresh": schema.StringAttribute{Description: "Time of the next scheduled refresh of a secondary.", Computed: true},
+		"owner":                  computedString(fmt.Sprintf("Role that owns the %s.", kind)),
+		"created_on":             computedString(fmt.Sprintf("Creation timestamp of the %s.", kind)),
+	}
+}
+
+// validate checks the dependencies between object types and allowed lists,
+// and that secondaries do not set primary-only attributes.
+func (m *SnowflakeReplicationGroupResourceModel) validate(ctx context.Context) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if m.ReplicaOf.IsUnknown() || m.ObjectTypes.IsUnknown() {
+		return diags
+	}
+
+	primaryOnly := map[string]types.Set{
+		"object_types":              m.ObjectTypes,
+		"allowed_databases":         m.AllowedDatabases,
+		"allowed_shares":            m.AllowedShares,
+		"allowed_integration_types": m.AllowedIntegrationTypes,
+		"allowed_accounts":          m.AllowedAccounts,
+	}
+
+	if !m.ReplicaOf.IsNull() {
+		for name, value := range primaryOnly {
+			if !value.IsNull() {
+				diags.AddAttributeError(
+					path.Root(name),
+					"Invalid Attribute for Secondary Group",
+					fmt.Sprintf("%s cannot be set on a secondary group; it is replicated from %s.", name, m.ReplicaOf.ValueString()),
+				)
+			}
+		}
+		return diags
+	}
+
+	if m.ObjectTypes.IsNull() {
+		diags.AddAttributeError(
+			path.Root("object_types"),
+			"Missing Object Types",
+			"object_types is required on a primary group.",
+		)
+		return diags
+	}
+
+	var objectTypes []types.String
+	diags.Append(m.ObjectTypes.ElementsAs(ctx, &objectTypes, false)...)
+	enabled := stringValues(objectTypes)
+
+	requirements := map[string]string{
+		"allowed_databases":         "DATABASES",
+		"allowed_shares":            "SHARES",
+		"allowed_integration_types": "INTEGRATIONS",
+	}
+	for name, objectType := range requirements {
+		if value := primaryOnly[name]; !value.IsNull() && !value.IsUnknown() && !stringInSlice(objectType, enabled) {
+			diags.AddAttributeError(
+				path.Root(name),
+				"Object Type Not Replicated",
+				fmt.Sprintf("%s requires %s in object_types.", name, objectType),
+			)
+		}
+	}
+	if stringInSlice("INTEGRATIONS", enabled) && m.AllowedIntegrationTypes.IsNull() {
+		diags.AddAttributeError(
+			path.Root("allowed_integration_types"),
+			"Missing Integration Types",
+			"allowed_integration_types is required when INTEGRATIONS is in object_types.",
+		)
+	}
+
+	return diags
+}
+
+// payload returns the OVH API representation of the group's settings.
+func (m *SnowflakeReplicationGroupResourceModel) payload(ctx context.Context) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	values := func(set types.Set) []string {
+		var elements []types.String
+		if !set.IsNull() && !set.IsUnknown() {
+			diags.Append(set.ElementsAs(ctx, &elements, false)...)
+		}
+		return stringValues(elements)
+	}
+
+	return map[string]interface{}{
+		"objectTypes":             values(m.ObjectTypes),
+		"allowedDatabases":        values(m.AllowedDatabases),
+		"allowedShares":           values(m.AllowedShares),
+		"allowedIntegrationTypes": values(m.AllowedIntegrationTypes),
+		"allowedAccounts":         values(m.AllowedAccounts),
+		"replicationSchedule":     m.ReplicationSchedule.ValueString(),
+	}, diags
+}
+
+// refresh copies an OVH API replication or failover group into the model.
+func (m *SnowflakeReplicationGroupResourceModel) refresh(ctx context.Context, group map[string]interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	set := func(key string) types.Set {
+		value, d := types.SetValueFrom(ctx, types.StringType, stringValues(apiStringList(group, key)))
+		diags.Append(d...)
+		return value
+	}
+
+	m.Name = apiString(group, "name")
+	m.ObjectTypes = set("objectTypes")
+	m.AllowedDatabases = set("allowedDatabases")
+	m.AllowedShares = set("allowedShares")
+	m.AllowedIntegrationTypes = set("allowedIntegrationTypes")
+	m.AllowedAccounts = set("allowedAccounts")
+	m.ReplicationSchedule = apiOptionalString(group, "replicationSchedule")
+	m.IsPrimary = apiBool(group, "isPrimary")
+	m.PrimaryAccount = apiString(group, "primaryAccount")
+	m.Region = apiString(group, "region")
+	m.NextScheduledRefresh = apiOptionalString(group, "nextScheduledRefresh")
+	m.Owner = apiString(group, "owner")
+	m.CreatedOn = apiString(group, "createdOn")
+
+	return diags
+}
+
+// replicationGroupAPI performs the OVH API calls shared by replication and
+// failover groups.
+type replicationGroupAPI struct {
+	config   *Config
+	endpoint string
+	kind     string
+	title    string
+}
+
+func (a replicationGroupAPI) create(ctx context.Context, data *SnowflakeReplicationGroupResourceModel) diag.Diagnostics {
+	tflog.Debug(ctx, "Creating Snowflake "+a.kind, map[string]interface{}{
+		"name":       data.Name.ValueString(),
+		"replica_of": data.ReplicaOf.ValueString(),
+	})
+
+	groupConfig, diags := data.payload(ctx)
+	if diags.HasError() {
+		return diags
+	}
+	groupConfig["name"] = data.Name.ValueString()
+	groupConfig["replicaOf"] = data.ReplicaOf.ValueString()
+
+	var result map[string]interface{}
+	err := a.config.OVHClient.Post(a.endpoint, groupConfig, &result)
+	if err != nil {
+		diags.AddError(
+			"Error Creating Snowflake "+a.title,
+			fmt.Sprintf("Could not create %s %s: %s", a.kind, data.Name.ValueString(), err),
+		)
+		return diags
+	}
+
+	data.ID = apiString(result, "id")
+
+	diags.Append(a.read(ctx, data)...)
+	return diags
+}
+
+func (a replicationGroupAPI) get(data *SnowflakeReplicationGroupResourceModel) (map[string]interface{}, error) {
+	var group map[string]interface{}
+	err := a.config.OVHClient.Get(fmt.Sprintf("%s/%s", a.endpoint, data.ID.ValueString()), &group)
+	return group, err
+}
+
+// read refreshes data from the OVH API after a create or update.
+func (a replicationGroupAPI) read(ctx context.Context, data *SnowflakeReplicationGroupResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	group, err := a.get(data)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Snowflake "+a.title,
+			fmt.Sprintf("Could not read %s %s: %s", a.kind, data.ID.ValueString(), err),
+		)
+		return diags
+	}
+
+	return data.refresh(ctx, group)
+}
+
+func (a replicationGroupAPI) update(ctx context.Context, data, state *SnowflakeReplicationGroupResourceModel) diag.Diagnostics {
+	tflog.Debug(ctx, "Updating Snowflake "+a.kind, map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	payload, diags := data.payload(ctx)
+	if diags.HasError() {
+		return diags
+	}
+
+	updateConfig := map[string]interface{}{}
+	if !data.ReplicationSchedule.Equal(state.ReplicationSchedule) {
+		updateConfig["replicationSchedule"] = payload["replicationSchedule"]
+	}
+
+	// Secondaries take their contents from the primary.
+	if data.ReplicaOf.IsNull() {
+		changes := map[string]bool{
+			"objectTypes":             !data.ObjectTypes.Equal(state.ObjectTypes),
+			"allowedDatabases":        !data.AllowedDatabases.Equal(state.AllowedDatabases),
+			"allowedShares":           !data.AllowedShares.Equal(state.AllowedShares),
+			"allowedIntegrationTypes": !data.AllowedIntegrationTypes.Equal(state.AllowedIntegrationTypes),
+			"allowedAccounts":         !data.AllowedAccounts.Equal(state.AllowedAccounts),
+		}
+		for key, changed := range changes {
+			if changed {
+				updateConfig[key] = payload[key]
+			}
+		}
+	}
+
+	if len(updateConfig) > 0 {
+		err := a.config.OVHClient.Put(fmt.Sprintf("%s/%s", a.endpoint, data.ID.ValueString()), updateConfig, nil)
+		if err != nil {
+			diags.AddError(
+				"Error Updating Snowflake "+a.title,
+				fmt.Sprintf("Could not update %s %s: %s", a.kind, data.ID.ValueString(), err),
+			)
+		}
+	}
+
+	return diags
+}
+
+func (a replicationGroupAPI) delete(ctx context.Context, data *SnowflakeReplicationGroupResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tflog.Debug(ctx, "Deleting Snowflake "+a.kind, map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err := a.config.OVHClient.Delete(fmt.Sprintf("%s/%s", a.endpoint, data.ID.ValueString()), nil)
+	if err != nil && !isNotFoundError(err) {
+		diags.AddError(
+			"Error Deleting Snowflake "+a.title,
+			fmt.Sprintf("Could not delete %s %s: %s", a.kind, data.ID.ValueString(), err),
+		)
+	}
+	return diags
+}
+
+func (r *SnowflakeReplicationGroupResource) api() replicationGroupAPI {
+	return replicationGroupAPI{
+		config:   r.config,
+		endpoint: "/cloud/project/snowflake/replication-group",
+		kind:     "replication group",
+		title:    "Replication Group",
+	}
+}
+
+func (r *SnowflakeReplicationGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_replication_group"
+}
+
+func (r *SnowflakeReplicationGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Snowflake replication group on OVH infrastructure. Secondaries in accounts in other OVH regions hold read-only copies of the group's objects; use snowflake_failover_group when secondaries must be able to take over.",
+		Attributes:  replicationGroupAttributes("replication group"),
+	}
+}
+
+func (r *SnowflakeReplicationGroupResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = replicationGroupIdentity.schema()
+}
+
+func (r *SnowflakeReplicationGroupResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data SnowflakeReplicationGroupResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.validate(ctx)...)
+}
+
+func (r *SnowflakeReplicationGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.config = config
+}
+
+func (r *SnowflakeReplicationGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SnowflakeReplicationGroupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.api().create(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Created Snowflake replication group")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(replicationGroupIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
+}
+
+func (r *SnowflakeReplicationGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SnowflakeReplicationGroupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Snowflake replication group", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	group, err := r.api().get(&data)
+	if isNotFoundError(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Snowflake Replication Group",
+			fmt.Sprintf("Could not read replication group %s: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(data.refresh(ctx, group)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(replicationGroupIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
+}
+
+func (r *SnowflakeReplicationGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state SnowflakeReplicationGroupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.api().update(ctx, &data, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.api().read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(replicationGroupIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
+}
+
+func (r *SnowflakeReplicationGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SnowflakeReplicationGroupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.api().delete(ctx, &data)...)
+}
+
+func (r *SnowflakeReplicationGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = replicationGroupIdentity.importID(ctx, r.config, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	importAccountObject(ctx, r.config, r.api().kind, r.api().endpoint, req, resp)
+}
@@ -0,0 +1,239 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testStringSet(t *testing.T, values ...string) types.Set {
+	t.Helper()
+
+	set, diags := types.SetValueFrom(context.Background(), types.StringType, values)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics building set: %v", diags)
+	}
+	return set
+}
+
+// testReplicationGroup returns a group model with every set null, which
+// the test cases then fill in.
+func testReplicationGroup(mutate func(m *SnowflakeReplicationGroupResourceModel)) SnowflakeReplicationGroupResourceModel {
+	null := types.SetNull(types.StringType)
+	m := SnowflakeReplicationGroupResourceModel{
+		ID:                      types.StringValue("1"),
+		Name:                    types.StringValue("REPLICATION"),
+		ReplicaOf:               types.StringNull(),
+		ObjectTypes:             null,
+		AllowedDatabases:        null,
+		AllowedShares:           null,
+		AllowedIntegrationTypes: null,
+		AllowedAccounts:         null,
+		ReplicationSchedule:     types.StringNull(),
+	}
+	mutate(&m)
+	return m
+}
+
+func TestSnowflakeReplicationGroup_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		mutate    func(m *SnowflakeReplicationGroupResourceModel)
+		wantPaths []path.Path
+	}{
+		"primary": {
+			mutate: func(m *SnowflakeReplicationGroupResourceModel) {
+				m.ObjectTypes = testStringSet(t, "DATABASES", "ROLES")
+				m.AllowedDatabases = testStringSet(t, "ANALYTICS")
+				m.AllowedAccounts = testStringSet(t, "ACME.DR")
+			},
+		},
+		"primary_without_object_types": {
+			mutate:    func(m *SnowflakeReplicationGroupResourceModel) {},
+			wantPaths: []path.Path{path.Root("object_types")},
+		},
+		"allowed_databases_without_databases": {
+			mutate: func(m *SnowflakeReplicationGroupResourceModel) {
+				m.ObjectTypes = testStringSet(t, "ROLES")
+				m.AllowedDatabases = testStringSet(t, "ANALYTICS")
+			},
+			wantPaths: []path.Path{path.Root("allowed_databases")},
+		},
+		"allowed_shares_without_shares": {
+			mutate: func(m *SnowflakeReplicationGroupResourceModel) {
+				m.ObjectTypes = testStringSet(t, "DATABASES")
+				m.AllowedShares = testStringSet(t, "PARTNER_SHARE")
+			},
+			wantPaths: []path.Path{path.Root("allowed_shares")},
+		},
+		"integrations_without_integration_types": {
+			mutate: func(m *SnowflakeReplicationGroupResourceModel) {
+				m.ObjectTypes = testStringSet(t, "INTEGRATIONS")
+			},
+			wantPaths: []path.Path{path.Root("allowed_integration_types")},
+		},
+		"integration_types_without_integrations": {
+			mutate: func(m *SnowflakeReplicationGroupResourceModel) {
+				m.ObjectTypes = testStringSet(t, "ROLES")
+				m.AllowedIntegrationTypes = testStringSet(t, "STORAGE INTEGRATIONS")
+			},
+			wantPaths: []path.Path{path.Root("allowed_integration_types")},
+		},
+		"integrations": {
+			mutate: func(m *SnowflakeReplicationGroupResourceModel) {
+				m.ObjectTypes = testStringSet(t, "INTEGRATIONS")
+				m.AllowedIntegrationTypes = testStringSet(t, "STORAGE INTEGRATIONS")
+			},
+		},
+		"secondary": {
+			mutate: func(m *SnowflakeReplicationGroupResourceModel) {
+				m.ReplicaOf = types.StringValue("ACME.PRIMARY.REPLICATION")
+				m.ReplicationSchedule = types.StringValue("10 MINUTE")
+			},
+		},
+		"secondary_with_primary_only_attributes": {
+			mutate: func(m *SnowflakeReplicationGroupResourceModel) {
+				m.ReplicaOf = types.StringValue("ACME.PRIMARY.REPLICATION")
+				m.ObjectTypes = testStringSet(t, "DATABASES")
+				m.AllowedAccounts = testStringSet(t, "ACME.DR")
+			},
+			wantPaths: []path.Path{path.Root("object_types"), path.Root("allowed_accounts")},
+		},
+		"unknown_replica_of": {
+			mutate: func(m *SnowflakeReplicationGroupResourceModel) {
+				m.ReplicaOf = types.StringUnknown()
+				m.ObjectTypes = testStringSet(t, "DATABASES")
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			m := testReplicationGroup(tc.mutate)
+			diags := m.validate(context.Background())
+
+			if len(diags) != len(tc.wantPaths) {
+				t.Fatalf("expected %d errors, got %v", len(tc.wantPaths), diags)
+			}
+			for _, want := range tc.wantPaths {
+				found := false
+				for _, d := range diags {
+					if withPath, ok := d.(interface{ Path() path.Path }); ok && withPath.Path().Equal(want) {
+						found = true
+					}
+				}
+				if !found {
+					t.Errorf("expected an error on %s, got %v", want, diags)
+				}
+			}
+		})
+	}
+}
+
+func TestSnowflakeReplicationGroup_Payload(t *testing.T) {
+	m := testReplicationGroup(func(m *SnowflakeReplicationGroupResourceModel) {
+		m.ObjectTypes = testStringSet(t, "DATABASES")
+		m.AllowedDatabases = testStringSet(t, "ANALYTICS")
+		m.AllowedAccounts = types.SetUnknown(types.StringType)
+		m.ReplicationSchedule = types.StringValue("10 MINUTE")
+	})
+
+	payload, diags := m.payload(context.Background())
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	// Null and unknown sets are sent as empty lists.
+	want := map[string]interface{}{
+		"objectTypes":             []string{"DATABASES"},
+		"allowedDatabases":        []string{"ANALYTICS"},
+		"allowedShares":           []string{},
+		"allowedIntegrationTypes": []string{},
+		"allowedAccounts":         []string{},
+		"replicationSchedule":     "10 MINUTE",
+	}
+	for key, value := range want {
+		got := payload[key]
+		if list, ok := got.([]string); ok && list == nil {
+			got = []string{}
+		}
+		if !reflect.DeepEqual(got, value) {
+			t.Errorf("%s = %#v, want %#v", key, payload[key], value)
+		}
+	}
+}
+
+func TestSnowflakeReplicationGroup_Update(t *testing.T) {
+	const update = "PUT /cloud/project/snowflake/replication-group/1"
+
+	testCases := map[string]struct {
+		state, plan SnowflakeReplicationGroupResourceModel
+		want        map[string]interface{}
+	}{
+		"primary": {
+			state: testReplicationGroup(func(m *SnowflakeReplicationGroupResourceModel) {
+				m.ObjectTypes = testStringSet(t, "DATABASES")
+				m.AllowedDatabases = testStringSet(t, "ANALYTICS")
+			}),
+			plan: testReplicationGroup(func(m *SnowflakeReplicationGroupResourceModel) {
+				m.ObjectTypes = testStringSet(t, "DATABASES")
+				m.AllowedDatabases = testStringSet(t, "ANALYTICS", "FINANCE")
+				m.ReplicationSchedule = types.StringValue("10 MINUTE")
+			}),
+			want: map[string]interface{}{
+				"allowedDatabases":    []string{"ANALYTICS", "FINANCE"},
+				"replicationSchedule": "10 MINUTE",
+			},
+		},
+		// Secondaries only ever change their own refresh schedule, even when
+		// the refreshed state holds the primary's object types.
+		"secondary": {
+			state: testReplicationGroup(func(m *SnowflakeReplicationGroupResourceModel) {
+				m.ReplicaOf = types.StringValue("ACME.PRIMARY.REPLICATION")
+				m.ObjectTypes = testStringSet(t, "DATABASES")
+			}),
+			plan: testReplicationGroup(func(m *SnowflakeReplicationGroupResourceModel) {
+				m.ReplicaOf = types.StringValue("ACME.PRIMARY.REPLICATION")
+				m.ReplicationSchedule = types.StringValue("USING CRON 0 * * * * UTC")
+			}),
+			want: map[string]interface{}{
+				"replicationSchedule": "USING CRON 0 * * * * UTC",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			api, config := newFakeOVHAPI(t, map[string]interface{}{update: nil})
+			r := &SnowflakeReplicationGroupResource{config: config}
+
+			diags := r.api().update(context.Background(), &tc.plan, &tc.state)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			body := api.body(update)
+			if len(body) != len(tc.want) {
+				t.Errorf("expected %v, got %v", tc.want, body)
+			}
+			for key, value := range tc.want {
+				got := body[key]
+				if list, ok := got.([]interface{}); ok {
+					// Sets are sent in no particular order.
+					names := make([]string, 0, len(list))
+					for _, item := range list {
+						names = append(names, item.(string))
+					}
+					slices.Sort(names)
+					got = names
+				}
+				if !reflect.DeepEqual(got, value) {
+					t.Errorf("%s = %#v, want %#v", key, body[key], value)
+				}
+			}
+		})
+	}
+}
@@ -4,13 +4,26 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/swcstudio/terraform-provider-ovh.snowflake/internal/sdk/identifiers"
+)
+
+var (
+	_ resource.Resource                 = &SnowflakeResourceMonitorResource{}
+	_ resource.ResourceWithImportState  = &SnowflakeResourceMonitorResource{}
+	_ resource.ResourceWithIdentity     = &SnowflakeResourceMonitorResource{}
+	_ resource.ResourceWithUpgradeState = &SnowflakeResourceMonitorResource{}
 )
 
-var _ resource.Resource = &SnowflakeResourceMonitorResource{}
+var resourceMonitorIdentity = accountObjectIdentity("resource monitor")
 
 func NewSnowflakeResourceMonitorResource() resource.Resource {
 	return &SnowflakeResourceMonitorResource{}
@@ -38,26 +51,44 @@ func (r *SnowflakeResourceMonitorResource) Metadata(ctx context.Context, req res
 func (r *SnowflakeResourceMonitorResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages a Snowflake resource monitor on OVH infrastructure.",
+		Version:     1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "Unique identifier for the resource monitor.",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"name": schema.StringAttribute{
 				Description: "Name of the resource monitor.",
 				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					identifiers.ValidName(),
+				},
 			},
 			"credit_quota": schema.Int64Attribute{
 				Description: "Credit quota for the resource monitor.",
 				Optional:    true,
 			},
 			"frequency": schema.StringAttribute{
-				Description: "Frequency of the resource monitor (MONTHLY, DAILY, WEEKLY, YEARLY, NEVER).",
+				Description: "Frequency of the resource monitor (MONTHLY, DAILY, WEEKLY, YEARLY, NEVER). Defaults to MONTHLY.",
 				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"start_time": schema.StringAttribute{
-				Description: "Start time for the resource monitor.",
+				Description: "Start time for the resource monitor. Defaults to the time the monitor is created.",
 				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"end_time": schema.StringAttribute{
 				Description: "End time for the resource monitor.",
@@ -75,6 +106,10 @@ func (r *SnowflakeResourceMonitorResource) Schema(ctx context.Context, req resou
 	}
 }
 
+func (r *SnowflakeResourceMonitorResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceMonitorIdentity.schema()
+}
+
 func (r *SnowflakeResourceMonitorResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -104,11 +139,47 @@ func (r *SnowflakeResourceMonitorResource) Create(ctx context.Context, req resou
 		"name": data.Name.ValueString(),
 	})
 
-	data.ID = types.StringValue(fmt.Sprintf("resource-monitor-%s", data.Name.ValueString()))
+	monitorConfig := map[string]interface{}{
+		"name":                     data.Name.ValueString(),
+		"suspendTriggers":          monitorTriggers(data.SuspendAt),
+		"suspendImmediateTriggers": monitorTriggers(data.SuspendImmediatelyAt),
+	}
+	if !data.CreditQuota.IsNull() {
+		monitorConfig["creditQuota"] = data.CreditQuota.ValueInt64()
+	}
+	// Snowflake starts monitors immediately and resets them monthly unless
+	// told otherwise.
+	if !data.Frequency.IsUnknown() {
+		monitorConfig["frequency"] = data.Frequency.ValueString()
+	}
+	if !data.StartTime.IsUnknown() {
+		monitorConfig["startTimestamp"] = data.StartTime.ValueString()
+	}
+	if !data.EndTime.IsNull() {
+		monitorConfig["endTimestamp"] = data.EndTime.ValueString()
+	}
+
+	var result map[string]interface{}
+	err := r.config.OVHClient.Post("/cloud/project/snowflake/resource-monitor", monitorConfig, &result)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Snowflake Resource Monitor",
+			fmt.Sprintf("Could not create resource monitor %s: %s", data.Name.ValueString(), err),
+		)
+		return
+	}
+
+	data.ID = apiString(result, "id")
+
+	resp.Diagnostics.Append(r.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	tflog.Trace(ctx, "Created Snowflake resource monitor")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resourceMonitorIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
 }
 
 func (r *SnowflakeResourceMonitorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -123,13 +194,38 @@ func (r *SnowflakeResourceMonitorResource) Read(ctx context.Context, req resourc
 		"id": data.ID.ValueString(),
 	})
 
+	resp.Diagnostics.Append(resolveMissingID(r.config, "resource monitor", "/cloud/project/snowflake/resource-monitor", map[string]string{
+		"name": data.Name.ValueString(),
+	}, &data.ID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var monitor map[string]interface{}
+	err := r.config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/resource-monitor/%s", data.ID.ValueString()), &monitor)
+	if isNotFoundError(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Snowflake Resource Monitor",
+			fmt.Sprintf("Could not read resource monitor %s: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	data.refresh(monitor)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resourceMonitorIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
 }
 
 func (r *SnowflakeResourceMonitorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data SnowflakeResourceMonitorResourceModel
+	var data, state SnowflakeResourceMonitorResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -138,7 +234,44 @@ func (r *SnowflakeResourceMonitorResource) Update(ctx context.Context, req resou
 		"id": data.ID.ValueString(),
 	})
 
+	updateConfig := map[string]interface{}{}
+	if !data.CreditQuota.Equal(state.CreditQuota) {
+		updateConfig["creditQuota"] = data.CreditQuota.ValueInt64()
+	}
+	if !data.Frequency.IsUnknown() && !data.Frequency.Equal(state.Frequency) {
+		updateConfig["frequency"] = data.Frequency.ValueString()
+	}
+	if !data.StartTime.IsUnknown() && !data.StartTime.Equal(state.StartTime) {
+		updateConfig["startTimestamp"] = data.StartTime.ValueString()
+	}
+	if !data.EndTime.Equal(state.EndTime) {
+		updateConfig["endTimestamp"] = data.EndTime.ValueString()
+	}
+	if !data.SuspendAt.Equal(state.SuspendAt) {
+		updateConfig["suspendTriggers"] = monitorTriggers(data.SuspendAt)
+	}
+	if !data.SuspendImmediatelyAt.Equal(state.SuspendImmediatelyAt) {
+		updateConfig["suspendImmediateTriggers"] = monitorTriggers(data.SuspendImmediatelyAt)
+	}
+
+	if len(updateConfig) > 0 {
+		err := r.config.OVHClient.Put(fmt.Sprintf("/cloud/project/snowflake/resource-monitor/%s", data.ID.ValueString()), updateConfig, nil)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Snowflake Resource Monitor",
+				fmt.Sprintf("Could not update resource monitor %s: %s", data.ID.ValueString(), err),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resourceMonitorIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
 }
 
 func (r *SnowflakeResourceMonitorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -152,4 +285,124 @@ func (r *SnowflakeResourceMonitorResource) Delete(ctx context.Context, req resou
 	tflog.Debug(ctx, "Deleting Snowflake resource monitor", map[string]interface{}{
 		"id": data.ID.ValueString(),
 	})
+
+	err := r.config.OVHClient.Delete(fmt.Sprintf("/cloud/project/snowflake/resource-monitor/%s", data.ID.ValueString()), nil)
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Snowflake Resource Monitor",
+			fmt.Sprintf("Could not delete resource monitor %s: %s", data.ID.ValueString(), err),
+		)
+	}
+}
+
+func (r *SnowflakeResourceMonitorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = resourceMonitorIdentity.importID(ctx, r.config, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	importAccountObject(ctx, r.config, "resource monitor", "/cloud/project/snowflake/resource-monitor", req, resp)
+}
+
+// snowflakeResourceMonitorResourceModelV0 is the state of the resource monitor at version 0.
+type snowflakeResourceMonitorResourceModelV0 struct {
+	ID                   types.String `tfsdk:"id"`
+	Name                 types.String `tfsdk:"name"`
+	CreditQuota          types.Int64  `tfsdk:"credit_quota"`
+	Frequency            types.String `tfsdk:"frequency"`
+	StartTime            types.String `tfsdk:"start_time"`
+	EndTime              types.String `tfsdk:"end_time"`
+	SuspendAt            types.Int64  `tfsdk:"suspend_at"`
+	SuspendImmediatelyAt types.Int64  `tfsdk:"suspend_immediately_at"`
+}
+
+// UpgradeState upgrades state from version 0, which stored a placeholder
+// rather than the OVH ID of the resource monitor.
+func (r *SnowflakeResourceMonitorResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":                     schema.StringAttribute{Computed: true},
+					"name":                   schema.StringAttribute{Required: true},
+					"credit_quota":           schema.Int64Attribute{Optional: true},
+					"frequency":              schema.StringAttribute{Optional: true, Computed: true},
+					"start_time":             schema.StringAttribute{Optional: true, Computed: true},
+					"end_time":               schema.StringAttribute{Optional: true},
+					"suspend_at":             schema.Int64Attribute{Optional: true},
+					"suspend_immediately_at": schema.Int64Attribute{Optional: true},
+				},
+			},
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var prior snowflakeResourceMonitorResourceModelV0
+				resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				data := SnowflakeResourceMonitorResourceModel{
+					ID:                   upgradeStubID(prior.ID, "resource-monitor-%s", prior.Name),
+					Name:                 prior.Name,
+					CreditQuota:          prior.CreditQuota,
+					Frequency:            prior.Frequency,
+					StartTime:            prior.StartTime,
+					EndTime:              prior.EndTime,
+					SuspendAt:            prior.SuspendAt,
+					SuspendImmediatelyAt: prior.SuspendImmediatelyAt,
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			},
+		},
+	}
+}
+
+// read refreshes data from the OVH API after a create or update.
+func (r *SnowflakeResourceMonitorResource) read(ctx context.Context, data *SnowflakeResourceMonitorResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var monitor map[string]interface{}
+	err := r.config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/resource-monitor/%s", data.ID.ValueString()), &monitor)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Snowflake Resource Monitor",
+			fmt.Sprintf("Could not read resource monitor %s: %s", data.ID.ValueString(), err),
+		)
+		return diags
+	}
+
+	data.refresh(monitor)
+	return diags
+}
+
+// refresh copies an OVH API resource monitor into the model. The API reports
+// suspend thresholds as lists of trigger percentages, of which this resource
+// manages the first.
+func (m *SnowflakeResourceMonitorResourceModel) refresh(monitor map[string]interface{}) {
+	m.Name = apiString(monitor, "name")
+	m.CreditQuota = apiInt64(monitor, "creditQuota")
+	m.Frequency = apiString(monitor, "frequency")
+	m.StartTime = apiString(monitor, "startTimestamp")
+	m.EndTime = apiOptionalString(monitor, "endTimestamp")
+	m.SuspendAt = firstTrigger(monitor, "suspendTriggers")
+	m.SuspendImmediatelyAt = firstTrigger(monitor, "suspendImmediateTriggers")
+}
+
+// monitorTriggers returns the trigger list to send for a threshold, which is
+// empty when the threshold is not set.
+func monitorTriggers(threshold types.Int64) []int64 {
+	if threshold.IsNull() || threshold.IsUnknown() {
+		return []int64{}
+	}
+	return []int64{threshold.ValueInt64()}
+}
+
+// firstTrigger returns the first percentage in the trigger list stored under
+// key, or null when the list is empty.
+func firstTrigger(monitor map[string]interface{}, key string) types.Int64 {
+	triggers, ok := monitor[key].([]interface{})
+	if !ok || len(triggers) == 0 {
+		return types.Int64Null()
+	}
+	return apiInt64(map[string]interface{}{key: triggers[0]}, key)
 }
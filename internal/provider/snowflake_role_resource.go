@@ -4,13 +4,27 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/swcstudio/terraform-provider-ovh.snowflake/internal/sdk/identifiers"
+)
+
+var (
+	_ resource.Resource                 = &SnowflakeRoleResource{}
+	_ resource.ResourceWithImportState  = &SnowflakeRoleResource{}
+	_ resource.ResourceWithIdentity     = &SnowflakeRoleResource{}
+	_ resource.ResourceWithMoveState    = &SnowflakeRoleResource{}
+	_ resource.ResourceWithUpgradeState = &SnowflakeRoleResource{}
 )
 
-var _ resource.Resource = &SnowflakeRoleResource{}
+var roleIdentity = accountObjectIdentity("role")
 
 func NewSnowflakeRoleResource() resource.Resource {
 	return &SnowflakeRoleResource{}
@@ -33,14 +47,24 @@ func (r *SnowflakeRoleResource) Metadata(ctx context.Context, req resource.Metad
 func (r *SnowflakeRoleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages a Snowflake role on OVH infrastructure.",
+		Version:     1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "Unique identifier for the role.",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"name": schema.StringAttribute{
 				Description: "Name of the role.",
 				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					identifiers.ValidName(),
+				},
 			},
 			"comment": schema.StringAttribute{
 				Description: "Comment for the role.",
@@ -50,6 +74,10 @@ func (r *SnowflakeRoleResource) Schema(ctx context.Context, req resource.SchemaR
 	}
 }
 
+func (r *SnowflakeRoleResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = roleIdentity.schema()
+}
+
 func (r *SnowflakeRoleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -79,11 +107,32 @@ func (r *SnowflakeRoleResource) Create(ctx context.Context, req resource.CreateR
 		"name": data.Name.ValueString(),
 	})
 
-	data.ID = types.StringValue(fmt.Sprintf("role-%s", data.Name.ValueString()))
+	roleConfig := map[string]interface{}{
+		"name":    data.Name.ValueString(),
+		"comment": data.Comment.ValueString(),
+	}
+
+	var result map[string]interface{}
+	err := r.config.OVHClient.Post("/cloud/project/snowflake/role", roleConfig, &result)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Snowflake Role",
+			fmt.Sprintf("Could not create role %s: %s", data.Name.ValueString(), err),
+		)
+		return
+	}
+
+	data.ID = apiString(result, "id")
+
+	resp.Diagnostics.Append(r.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	tflog.Trace(ctx, "Created Snowflake role")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(roleIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
 }
 
 func (r *SnowflakeRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -98,13 +147,38 @@ func (r *SnowflakeRoleResource) Read(ctx context.Context, req resource.ReadReque
 		"id": data.ID.ValueString(),
 	})
 
+	resp.Diagnostics.Append(resolveMissingID(r.config, "role", "/cloud/project/snowflake/role", map[string]string{
+		"name": data.Name.ValueString(),
+	}, &data.ID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var role map[string]interface{}
+	err := r.config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/role/%s", data.ID.ValueString()), &role)
+	if isNotFoundError(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Snowflake Role",
+			fmt.Sprintf("Could not read role %s: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	data.refresh(role)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(roleIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
 }
 
 func (r *SnowflakeRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data SnowflakeRoleResourceModel
+	var data, state SnowflakeRoleResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -113,7 +187,27 @@ func (r *SnowflakeRoleResource) Update(ctx context.Context, req resource.UpdateR
 		"id": data.ID.ValueString(),
 	})
 
+	if !data.Comment.Equal(state.Comment) {
+		updateConfig := map[string]interface{}{
+			"comment": data.Comment.ValueString(),
+		}
+		err := r.config.OVHClient.Put(fmt.Sprintf("/cloud/project/snowflake/role/%s", data.ID.ValueString()), updateConfig, nil)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Snowflake Role",
+				fmt.Sprintf("Could not update role %s: %s", data.ID.ValueString(), err),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(roleIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
 }
 
 func (r *SnowflakeRoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -127,4 +221,98 @@ func (r *SnowflakeRoleResource) Delete(ctx context.Context, req resource.DeleteR
 	tflog.Debug(ctx, "Deleting Snowflake role", map[string]interface{}{
 		"id": data.ID.ValueString(),
 	})
+
+	err := r.config.OVHClient.Delete(fmt.Sprintf("/cloud/project/snowflake/role/%s", data.ID.ValueString()), nil)
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Snowflake Role",
+			fmt.Sprintf("Could not delete role %s: %s", data.ID.ValueString(), err),
+		)
+	}
+}
+
+func (r *SnowflakeRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = roleIdentity.importID(ctx, r.config, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	importAccountObject(ctx, r.config, "role", "/cloud/project/snowflake/role", req, resp)
+}
+
+// MoveState moves roles from the upstream Snowflake provider, which names the
+// resource snowflake_account_role since it added database roles.
+func (r *SnowflakeRoleResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		upstreamStateMover([]string{"snowflake_role", "snowflake_account_role"}, func(ctx context.Context, sourceType string, source upstreamState, resp *resource.MoveStateResponse) {
+			data := SnowflakeRoleResourceModel{
+				Name:    source.string("name"),
+				Comment: source.string("comment"),
+			}
+
+			resp.Diagnostics.Append(resp.TargetState.Set(ctx, &data)...)
+		}),
+	}
+}
+
+// snowflakeRoleResourceModelV0 is the state of the role at version 0.
+type snowflakeRoleResourceModelV0 struct {
+	ID      types.String `tfsdk:"id"`
+	Name    types.String `tfsdk:"name"`
+	Comment types.String `tfsdk:"comment"`
+}
+
+// UpgradeState upgrades state from version 0, which stored a placeholder
+// rather than the OVH ID of the role.
+func (r *SnowflakeRoleResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":      schema.StringAttribute{Computed: true},
+					"name":    schema.StringAttribute{Required: true},
+					"comment": schema.StringAttribute{Optional: true},
+				},
+			},
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var prior snowflakeRoleResourceModelV0
+				resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				data := SnowflakeRoleResourceModel{
+					ID:      upgradeStubID(prior.ID, "role-%s", prior.Name),
+					Name:    prior.Name,
+					Comment: prior.Comment,
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			},
+		},
+	}
+}
+
+// read refreshes data from the OVH API after a create or update.
+func (r *SnowflakeRoleResource) read(ctx context.Context, data *SnowflakeRoleResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var role map[string]interface{}
+	err := r.config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/role/%s", data.ID.ValueString()), &role)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Snowflake Role",
+			fmt.Sprintf("Could not read role %s: %s", data.ID.ValueString(), err),
+		)
+		return diags
+	}
+
+	data.refresh(role)
+	return diags
+}
+
+// refresh copies an OVH API role into the model.
+func (m *SnowflakeRoleResourceModel) refresh(role map[string]interface{}) {
+	m.Name = apiString(role, "name")
+	m.Comment = apiOptionalString(role, "comment")
 }
@@ -0,0 +1,301 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/swcstudio/terraform-provider-ovh.snowflake/internal/sdk/identifiers"
+)
+
+var (
+	_ resource.Resource                = &SnowflakeRowAccessPolicyAttachmentResource{}
+	_ resource.ResourceWithImportState = &SnowflakeRowAccessPolicyAttachmentResource{}
+	_ resource.ResourceWithIdentity    = &SnowflakeRowAccessPolicyAttachmentResource{}
+)
+
+var rowAccessPolicyAttachmentIdentity = resourceIdentity{
+	{name: "object_type", description: "Type of the object the policy is attached to."},
+	{name: "object_name", description: "Fully qualified name of the object the policy is attached to."},
+}
+
+func NewSnowflakeRowAccessPolicyAttachmentResource() resource.Resource {
+	return &SnowflakeRowAccessPolicyAttachmentResource{}
+}
+
+type SnowflakeRowAccessPolicyAttachmentResource struct {
+	config *Config
+}
+
+type SnowflakeRowAccessPolicyAttachmentResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Policy     types.String `tfsdk:"policy"`
+	ObjectType types.String `tfsdk:"object_type"`
+	ObjectName types.String `tfsdk:"object_name"`
+	On         types.List   `tfsdk:"on"`
+}
+
+func (r *SnowflakeRowAccessPolicyAttachmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_row_access_policy_attachment"
+}
+
+func (r *SnowflakeRowAccessPolicyAttachmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Attaches a Snowflake row access policy to a table or view on OVH infrastructure. A table or view can have at most one row access policy. Changing any argument detaches the policy and attaches it again.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Unique identifier for the attachment.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"policy": schema.StringAttribute{
+				Description: "Fully qualified name of the row access policy.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					identifiers.ValidSchemaObjectIdentifier(),
+				},
+			},
+			"object_type": schema.StringAttribute{
+				Description: "Type of the object the policy is attached to (TABLE or VIEW).",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("TABLE"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					oneOfValidator{values: policyObjectTypes},
+				},
+			},
+			"object_name": schema.StringAttribute{
+				Description: "Fully qualified name of the table or view.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"on": schema.ListAttribute{
+				Description: "Columns passed to the policy, in the order of its signature.",
+				ElementType: types.StringType,
+				Required:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SnowflakeRowAccessPolicyAttachmentResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = rowAccessPolicyAttachmentIdentity.schema()
+}
+
+func (r *SnowflakeRowAccessPolicyAttachmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.config = config
+}
+
+func (r *SnowflakeRowAccessPolicyAttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SnowflakeRowAccessPolicyAttachmentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Attaching Snowflake row access policy", map[string]interface{}{
+		"policy": data.Policy.ValueString(),
+		"object": data.ObjectName.ValueString(),
+	})
+
+	var on []types.String
+	resp.Diagnostics.Append(data.On.ElementsAs(ctx, &on, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attachmentConfig := map[string]interface{}{
+		"policy":     data.Policy.ValueString(),
+		"objectType": data.ObjectType.ValueString(),
+		"objectName": data.ObjectName.ValueString(),
+		"on":         stringValues(on),
+	}
+
+	var result map[string]interface{}
+	err := r.config.OVHClient.Post("/cloud/project/snowflake/row-access-policy-attachment", attachmentConfig, &result)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Snowflake Row Access Policy Attachment",
+			fmt.Sprintf("Could not attach row access policy %s to %s: %s",
+				data.Policy.ValueString(), data.ObjectName.ValueString(), err),
+		)
+		return
+	}
+
+	data.ID = apiString(result, "id")
+
+	resp.Diagnostics.Append(r.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Attached Snowflake row access policy")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(rowAccessPolicyAttachmentIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
+}
+
+func (r *SnowflakeRowAccessPolicyAttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SnowflakeRowAccessPolicyAttachmentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Snowflake row access policy attachment", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	var attachment map[string]interface{}
+	err := r.config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/row-access-policy-attachment/%s", data.ID.ValueString()), &attachment)
+	if isNotFoundError(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Snowflake Row Access Policy Attachment",
+			fmt.Sprintf("Could not read row access policy attachment %s: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(data.refresh(ctx, attachment)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(rowAccessPolicyAttachmentIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
+}
+
+// Update is never called with changes because every argument requires
+// replacement; it only carries the plan over to state.
+func (r *SnowflakeRowAccessPolicyAttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SnowflakeRowAccessPolicyAttachmentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(rowAccessPolicyAttachmentIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
+}
+
+func (r *SnowflakeRowAccessPolicyAttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SnowflakeRowAccessPolicyAttachmentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Detaching Snowflake row access policy", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.config.OVHClient.Delete(fmt.Sprintf("/cloud/project/snowflake/row-access-policy-attachment/%s", data.ID.ValueString()), nil)
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Snowflake Row Access Policy Attachment",
+			fmt.Sprintf("Could not detach row access policy %s from %s: %s",
+				data.Policy.ValueString(), data.ObjectName.ValueString(), err),
+		)
+	}
+}
+
+// ImportState accepts IDs of the form object_type|object_name, as an object
+// has at most one row access policy.
+func (r *SnowflakeRowAccessPolicyAttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = rowAccessPolicyAttachmentIdentity.importID(ctx, r.config, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if importByID(ctx, r.config, "row access policy attachment", "/cloud/project/snowflake/row-access-policy-attachment", req.ID, resp) {
+		return
+	}
+
+	parts, err := splitImportID(req.ID, "object_type|object_name", 2, 2)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	importByLookup(ctx, r.config, "row access policy attachment", "/cloud/project/snowflake/row-access-policy-attachment", map[string]string{
+		"objectType": strings.ToUpper(parts[0]),
+		"objectName": parts[1],
+	}, resp)
+}
+
+// read refreshes data from the OVH API after a create.
+func (r *SnowflakeRowAccessPolicyAttachmentResource) read(ctx context.Context, data *SnowflakeRowAccessPolicyAttachmentResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var attachment map[string]interface{}
+	err := r.config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/row-access-policy-attachment/%s", data.ID.ValueString()), &attachment)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Snowflake Row Access Policy Attachment",
+			fmt.Sprintf("Could not read row access policy attachment %s: %s", data.ID.ValueString(), err),
+		)
+		return diags
+	}
+
+	return data.refresh(ctx, attachment)
+}
+
+// refresh copies an OVH API row access policy attachment into the model.
+func (m *SnowflakeRowAccessPolicyAttachmentResourceModel) refresh(ctx context.Context, attachment map[string]interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.Policy = apiIdentifier(attachment, "policy", m.Policy)
+	m.ObjectType = apiString(attachment, "objectType")
+	m.ObjectName = apiIdentifier(attachment, "objectName", m.ObjectName)
+
+	on, d := types.ListValueFrom(ctx, types.StringType, apiStringList(attachment, "on"))
+	diags.Append(d...)
+	m.On = on
+
+	return diags
+}
@@ -0,0 +1,370 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/swcstudio/terraform-provider-ovh.snowflake/internal/sdk/identifiers"
+)
+
+var (
+	_ resource.Resource                = &SnowflakeRowAccessPolicyResource{}
+	_ resource.ResourceWithImportState = &SnowflakeRowAccessPolicyResource{}
+	_ resource.ResourceWithIdentity    = &SnowflakeRowAccessPolicyResource{}
+)
+
+var rowAccessPolicyIdentity = schemaObjectIdentity("row access policy")
+
+func NewSnowflakeRowAccessPolicyResource() resource.Resource {
+	return &SnowflakeRowAccessPolicyResource{}
+}
+
+type SnowflakeRowAccessPolicyResource struct {
+	config *Config
+}
+
+type SnowflakeRowAccessPolicyResourceModel struct {
+	ID                 types.String      `tfsdk:"id"`
+	Name               types.String      `tfsdk:"name"`
+	Database           types.String      `tfsdk:"database"`
+	Schema             types.String      `tfsdk:"schema"`
+	Signature          types.List        `tfsdk:"signature"`
+	Body               SQLStatementValue `tfsdk:"body"`
+	Comment            types.String      `tfsdk:"comment"`
+	FullyQualifiedName types.String      `tfsdk:"fully_qualified_name"`
+	Owner              types.String      `tfsdk:"owner"`
+	CreatedOn          types.String      `tfsdk:"created_on"`
+}
+
+func (r *SnowflakeRowAccessPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_row_access_policy"
+}
+
+func (r *SnowflakeRowAccessPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Snowflake row access policy on OVH infrastructure. Attach it to tables and views with snowflake-ovh_row_access_policy_attachment.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Unique identifier for the row access policy.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the row access policy.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					identifiers.ValidName(),
+				},
+			},
+			"database": schema.StringAttribute{
+				Description: "Database that contains the row access policy.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					identifiers.ValidName(),
+				},
+			},
+			"schema": schema.StringAttribute{
+				Description: "Schema that contains the row access policy.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					identifiers.ValidName(),
+				},
+			},
+			"signature": policySignatureAttribute("Arguments of the policy, bound to the columns listed in the attachment's on attribute."),
+			"body": schema.StringAttribute{
+				Description: "SQL expression that returns a BOOLEAN deciding whether a row is visible. Changes are applied in place.",
+				Required:    true,
+				CustomType:  SQLStatementType{},
+			},
+			"comment": schema.StringAttribute{
+				Description: "Comment for the row access policy.",
+				Optional:    true,
+			},
+			"fully_qualified_name": schema.StringAttribute{
+				Description: "Fully qualified name of the row access policy.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"owner": schema.StringAttribute{
+				Description: "Role that owns the row access policy.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_on": schema.StringAttribute{
+				Description: "Creation timestamp of the row access policy.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SnowflakeRowAccessPolicyResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = rowAccessPolicyIdentity.schema()
+}
+
+func (r *SnowflakeRowAccessPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.config = config
+}
+
+func (r *SnowflakeRowAccessPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SnowflakeRowAccessPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating Snowflake row access policy", map[string]interface{}{
+		"name":     data.Name.ValueString(),
+		"database": data.Database.ValueString(),
+		"schema":   data.Schema.ValueString(),
+	})
+
+	signature, diags := expandPolicySignature(ctx, data.Signature)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policyConfig := map[string]interface{}{
+		"name":      data.Name.ValueString(),
+		"database":  data.Database.ValueString(),
+		"schema":    data.Schema.ValueString(),
+		"signature": signature,
+		"body":      data.Body.ValueString(),
+		"comment":   data.Comment.ValueString(),
+	}
+
+	var result map[string]interface{}
+	err := r.config.OVHClient.Post("/cloud/project/snowflake/row-access-policy", policyConfig, &result)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Snowflake Row Access Policy",
+			fmt.Sprintf("Could not create row access policy %s: %s", data.Name.ValueString(), err),
+		)
+		return
+	}
+
+	data.ID = apiString(result, "id")
+
+	resp.Diagnostics.Append(r.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Created Snowflake row access policy")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(rowAccessPolicyIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
+}
+
+func (r *SnowflakeRowAccessPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SnowflakeRowAccessPolicyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Snowflake row access policy", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	var policy map[string]interface{}
+	err := r.config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/row-access-policy/%s", data.ID.ValueString()), &policy)
+	if isNotFoundError(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Snowflake Row Access Policy",
+			fmt.Sprintf("Could not read row access policy %s: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(data.refresh(ctx, policy)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(rowAccessPolicyIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
+}
+
+func (r *SnowflakeRowAccessPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state SnowflakeRowAccessPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating Snowflake row access policy", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	updateConfig := map[string]interface{}{}
+
+	// ALTER ROW ACCESS POLICY ... SET BODY keeps existing attachments in place.
+	if !sqlEquivalent(data.Body.ValueString(), state.Body.ValueString()) {
+		updateConfig["body"] = data.Body.ValueString()
+	}
+	if !data.Comment.Equal(state.Comment) {
+		updateConfig["comment"] = data.Comment.ValueString()
+	}
+
+	if len(updateConfig) > 0 {
+		err := r.config.OVHClient.Put(fmt.Sprintf("/cloud/project/snowflake/row-access-policy/%s", data.ID.ValueString()), updateConfig, nil)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Snowflake Row Access Policy",
+				fmt.Sprintf("Could not update row access policy %s: %s", data.ID.ValueString(), err),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(rowAccessPolicyIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
+}
+
+func (r *SnowflakeRowAccessPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SnowflakeRowAccessPolicyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting Snowflake row access policy", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	path := fmt.Sprintf("/cloud/project/snowflake/row-access-policy/%s", data.ID.ValueString())
+
+	// Snowflake refuses to drop a policy that is still attached; report where
+	// it is used rather than passing on the generic SQL error.
+	references, err := policyReferences(r.config, path)
+	if isNotFoundError(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Snowflake Row Access Policy",
+			fmt.Sprintf("Could not list references of row access policy %s: %s", data.FullyQualifiedName.ValueString(), err),
+		)
+		return
+	}
+	if len(references) > 0 {
+		resp.Diagnostics.AddError(
+			"Snowflake Row Access Policy Still In Use",
+			fmt.Sprintf("Row access policy %s is still attached to: %s. Remove the snowflake-ovh_row_access_policy_attachment resources for these objects before destroying the policy.",
+				data.FullyQualifiedName.ValueString(), strings.Join(references, ", ")),
+		)
+		return
+	}
+
+	err = r.config.OVHClient.Delete(path, nil)
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Snowflake Row Access Policy",
+			fmt.Sprintf("Could not delete row access policy %s: %s", data.ID.ValueString(), err),
+		)
+	}
+}
+
+func (r *SnowflakeRowAccessPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = rowAccessPolicyIdentity.importID(ctx, r.config, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	importSchemaObject(ctx, r.config, "row access policy", "/cloud/project/snowflake/row-access-policy", req, resp)
+}
+
+// read refreshes data from the OVH API after a create or update.
+func (r *SnowflakeRowAccessPolicyResource) read(ctx context.Context, data *SnowflakeRowAccessPolicyResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var policy map[string]interface{}
+	err := r.config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/row-access-policy/%s", data.ID.ValueString()), &policy)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Snowflake Row Access Policy",
+			fmt.Sprintf("Could not read row access policy %s: %s", data.ID.ValueString(), err),
+		)
+		return diags
+	}
+
+	return data.refresh(ctx, policy)
+}
+
+// refresh copies an OVH API row access policy into the model.
+func (m *SnowflakeRowAccessPolicyResourceModel) refresh(ctx context.Context, policy map[string]interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.Name = apiString(policy, "name")
+	m.Database = apiString(policy, "database")
+	m.Schema = apiString(policy, "schema")
+	m.Body = SQLStatementValue{StringValue: apiString(policy, "body")}
+	m.Comment = apiOptionalString(policy, "comment")
+	m.Owner = apiString(policy, "owner")
+	m.CreatedOn = apiString(policy, "createdOn")
+	m.FullyQualifiedName = types.StringValue(identifiers.NewSchemaObjectIdentifier(
+		m.Database.ValueString(), m.Schema.ValueString(), m.Name.ValueString()).FullyQualifiedName())
+
+	signature, d := flattenPolicySignature(ctx, policy["signature"], m.Signature)
+	diags.Append(d...)
+	m.Signature = signature
+
+	return diags
+}
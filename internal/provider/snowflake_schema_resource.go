@@ -4,13 +4,27 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/swcstudio/terraform-provider-ovh.snowflake/internal/sdk/identifiers"
+)
+
+var (
+	_ resource.Resource                 = &SnowflakeSchemaResource{}
+	_ resource.ResourceWithImportState  = &SnowflakeSchemaResource{}
+	_ resource.ResourceWithIdentity     = &SnowflakeSchemaResource{}
+	_ resource.ResourceWithMoveState    = &SnowflakeSchemaResource{}
+	_ resource.ResourceWithUpgradeState = &SnowflakeSchemaResource{}
 )
 
-var _ resource.Resource = &SnowflakeSchemaResource{}
+var schemaIdentity = databaseObjectIdentity("schema")
 
 func NewSnowflakeSchemaResource() resource.Resource {
 	return &SnowflakeSchemaResource{}
@@ -34,18 +48,34 @@ func (r *SnowflakeSchemaResource) Metadata(ctx context.Context, req resource.Met
 func (r *SnowflakeSchemaResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages a Snowflake schema on OVH infrastructure.",
+		Version:     1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "Unique identifier for the schema.",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"name": schema.StringAttribute{
 				Description: "Name of the schema.",
 				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					identifiers.ValidName(),
+				},
 			},
 			"database": schema.StringAttribute{
 				Description: "Database that contains the schema.",
 				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					identifiers.ValidName(),
+				},
 			},
 			"comment": schema.StringAttribute{
 				Description: "Comment for the schema.",
@@ -55,6 +85,10 @@ func (r *SnowflakeSchemaResource) Schema(ctx context.Context, req resource.Schem
 	}
 }
 
+func (r *SnowflakeSchemaResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = schemaIdentity.schema()
+}
+
 func (r *SnowflakeSchemaResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -85,11 +119,33 @@ func (r *SnowflakeSchemaResource) Create(ctx context.Context, req resource.Creat
 		"database": data.Database.ValueString(),
 	})
 
-	data.ID = types.StringValue(fmt.Sprintf("schema-%s-%s", data.Database.ValueString(), data.Name.ValueString()))
+	schemaConfig := map[string]interface{}{
+		"name":     data.Name.ValueString(),
+		"database": data.Database.ValueString(),
+		"comment":  data.Comment.ValueString(),
+	}
+
+	var result map[string]interface{}
+	err := r.config.OVHClient.Post("/cloud/project/snowflake/schema", schemaConfig, &result)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Snowflake Schema",
+			fmt.Sprintf("Could not create schema %s: %s", data.Name.ValueString(), err),
+		)
+		return
+	}
+
+	data.ID = apiString(result, "id")
+
+	resp.Diagnostics.Append(r.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	tflog.Trace(ctx, "Created Snowflake schema")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(schemaIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
 }
 
 func (r *SnowflakeSchemaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -104,13 +160,39 @@ func (r *SnowflakeSchemaResource) Read(ctx context.Context, req resource.ReadReq
 		"id": data.ID.ValueString(),
 	})
 
+	resp.Diagnostics.Append(resolveMissingID(r.config, "schema", "/cloud/project/snowflake/schema", map[string]string{
+		"database": data.Database.ValueString(),
+		"name":     data.Name.ValueString(),
+	}, &data.ID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var schema map[string]interface{}
+	err := r.config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/schema/%s", data.ID.ValueString()), &schema)
+	if isNotFoundError(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Snowflake Schema",
+			fmt.Sprintf("Could not read schema %s: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	data.refresh(schema)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(schemaIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
 }
 
 func (r *SnowflakeSchemaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data SnowflakeSchemaResourceModel
+	var data, state SnowflakeSchemaResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -119,7 +201,27 @@ func (r *SnowflakeSchemaResource) Update(ctx context.Context, req resource.Updat
 		"id": data.ID.ValueString(),
 	})
 
+	if !data.Comment.Equal(state.Comment) {
+		updateConfig := map[string]interface{}{
+			"comment": data.Comment.ValueString(),
+		}
+		err := r.config.OVHClient.Put(fmt.Sprintf("/cloud/project/snowflake/schema/%s", data.ID.ValueString()), updateConfig, nil)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Snowflake Schema",
+				fmt.Sprintf("Could not update schema %s: %s", data.ID.ValueString(), err),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(schemaIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
 }
 
 func (r *SnowflakeSchemaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -133,4 +235,102 @@ func (r *SnowflakeSchemaResource) Delete(ctx context.Context, req resource.Delet
 	tflog.Debug(ctx, "Deleting Snowflake schema", map[string]interface{}{
 		"id": data.ID.ValueString(),
 	})
+
+	err := r.config.OVHClient.Delete(fmt.Sprintf("/cloud/project/snowflake/schema/%s", data.ID.ValueString()), nil)
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Snowflake Schema",
+			fmt.Sprintf("Could not delete schema %s: %s", data.ID.ValueString(), err),
+		)
+	}
+}
+
+func (r *SnowflakeSchemaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = schemaIdentity.importID(ctx, r.config, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	importDatabaseObject(ctx, r.config, "schema", "/cloud/project/snowflake/schema", req, resp)
+}
+
+// MoveState moves schemas from the upstream Snowflake provider.
+func (r *SnowflakeSchemaResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		upstreamStateMover([]string{"snowflake_schema"}, func(ctx context.Context, sourceType string, source upstreamState, resp *resource.MoveStateResponse) {
+			data := SnowflakeSchemaResourceModel{
+				Name:     source.string("name"),
+				Database: source.string("database"),
+				Comment:  source.string("comment"),
+			}
+
+			resp.Diagnostics.Append(resp.TargetState.Set(ctx, &data)...)
+		}),
+	}
+}
+
+// snowflakeSchemaResourceModelV0 is the state of the schema at version 0.
+type snowflakeSchemaResourceModelV0 struct {
+	ID       types.String `tfsdk:"id"`
+	Name     types.String `tfsdk:"name"`
+	Database types.String `tfsdk:"database"`
+	Comment  types.String `tfsdk:"comment"`
+}
+
+// UpgradeState upgrades state from version 0, which stored a placeholder
+// rather than the OVH ID of the schema.
+func (r *SnowflakeSchemaResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":       schema.StringAttribute{Computed: true},
+					"name":     schema.StringAttribute{Required: true},
+					"database": schema.StringAttribute{Required: true},
+					"comment":  schema.StringAttribute{Optional: true},
+				},
+			},
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var prior snowflakeSchemaResourceModelV0
+				resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				data := SnowflakeSchemaResourceModel{
+					ID:       upgradeStubID(prior.ID, "schema-%s-%s", prior.Database, prior.Name),
+					Name:     prior.Name,
+					Database: prior.Database,
+					Comment:  prior.Comment,
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			},
+		},
+	}
+}
+
+// read refreshes data from the OVH API after a create or update.
+func (r *SnowflakeSchemaResource) read(ctx context.Context, data *SnowflakeSchemaResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var schema map[string]interface{}
+	err := r.config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/schema/%s", data.ID.ValueString()), &schema)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Snowflake Schema",
+			fmt.Sprintf("Could not read schema %s: %s", data.ID.ValueString(), err),
+		)
+		return diags
+	}
+
+	data.refresh(schema)
+	return diags
+}
+
+// refresh copies an OVH API schema into the model.
+func (m *SnowflakeSchemaResourceModel) refresh(schema map[string]interface{}) {
+	m.Name = apiString(schema, "name")
+	m.Database = apiString(schema, "database")
+	m.Comment = apiOptionalString(schema, "comment")
 }
@@ -0,0 +1,277 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/swcstudio/terraform-provider-ovh.snowflake/internal/sdk/identifiers"
+)
+
+// Security policy types, as accepted by snowflake-ovh_security_policy_attachment.
+const (
+	securityPolicyPassword       = "PASSWORD"
+	securityPolicySession        = "SESSION"
+	securityPolicyAuthentication = "AUTHENTICATION"
+)
+
+var securityPolicyTypes = []string{securityPolicyPassword, securityPolicySession, securityPolicyAuthentication}
+
+// SnowflakeSecurityPolicyResourceModel holds the attributes shared by
+// password, session and authentication policies, which embed it.
+type SnowflakeSecurityPolicyResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	Database           types.String `tfsdk:"database"`
+	Schema             types.String `tfsdk:"schema"`
+	Comment            types.String `tfsdk:"comment"`
+	FullyQualifiedName types.String `tfsdk:"fully_qualified_name"`
+	Owner              types.String `tfsdk:"owner"`
+	CreatedOn          types.String `tfsdk:"created_on"`
+}
+
+// policyInt64Attribute returns an optional integer policy setting that
+// defaults to the Snowflake default.
+func policyInt64Attribute(description string, defaultValue, min, max int64) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Description: fmt.Sprintf("%s Between %d and %d, defaults to %d.", description, min, max, defaultValue),
+		Optional:    true,
+		Computed:    true,
+		Default:     int64default.StaticInt64(defaultValue),
+		Validators: []validator.Int64{
+			int64RangeValidator{min: min, max: max},
+		},
+	}
+}
+
+// securityPolicyAttributes returns the schema attributes shared by security
+// policies. kind is "password policy", "session policy" or "authentication
+// policy".
+func securityPolicyAttributes(kind string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: fmt.Sprintf("Unique identifier for the %s.", kind),
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Description: fmt.Sprintf("Name of the %s.", kind),
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				identifiers.ValidName(),
+			},
+		},
+		"database": schema.StringAttribute{
+			Description: fmt.Sprintf("Database that contains the %s.", kind),
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				identifiers.ValidName(),
+			},
+		},
+		"schema": schema.StringAttribute{
+			Description: fmt.Sprintf("Schema that contains the %s.", kind),
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				identifiers.ValidName(),
+			},
+		},
+		"comment": schema.StringAttribute{
+			Description: fmt.Sprintf("Comment for the %s.", kind),
+			Optional:    true,
+		},
+		"fully_qualified_name": schema.StringAttribute{
+			Description: fmt.Sprintf("Fully qualified name of the %s.", kind),
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"owner": schema.StringAttribute{
+			Description: fmt.Sprintf("Role that owns the %s.", kind),
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"created_on": schema.StringAttribute{
+			Description: fmt.Sprintf("Creation timestamp of the %s.", kind),
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+}
+
+// refresh copies the shared attributes of an OVH API security policy into
+// the model.
+func (m *SnowflakeSecurityPolicyResourceModel) refresh(policy map[string]interface{}) {
+	m.Name = apiString(policy, "name")
+	m.Database = apiString(policy, "database")
+	m.Schema = apiString(policy, "schema")
+	m.Comment = apiOptionalString(policy, "comment")
+	m.Owner = apiString(policy, "owner")
+	m.CreatedOn = apiString(policy, "createdOn")
+	m.FullyQualifiedName = types.StringValue(identifiers.NewSchemaObjectIdentifier(
+		m.Database.ValueString(), m.Schema.ValueString(), m.Name.ValueString()).FullyQualifiedName())
+}
+
+// securityPolicyAPI performs the OVH API calls shared by security policies.
+// Policy-specific settings are passed as API payload maps.
+type securityPolicyAPI struct {
+	config   *Config
+	endpoint string
+	kind     string
+	title    string
+}
+
+func (a securityPolicyAPI) create(ctx context.Context, data *SnowflakeSecurityPolicyResourceModel, settings map[string]interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tflog.Debug(ctx, "Creating Snowflake "+a.kind, map[string]interface{}{
+		"name":     data.Name.ValueString(),
+		"database": data.Database.ValueString(),
+		"schema":   data.Schema.ValueString(),
+	})
+
+	policyConfig := map[string]interface{}{
+		"name":     data.Name.ValueString(),
+		"database": data.Database.ValueString(),
+		"schema":   data.Schema.ValueString(),
+		"comment":  data.Comment.ValueString(),
+	}
+	for key, value := range settings {
+		policyConfig[key] = value
+	}
+
+	var result map[string]interface{}
+	err := a.config.OVHClient.Post(a.endpoint, policyConfig, &result)
+	if err != nil {
+		diags.AddError(
+			"Error Creating Snowflake "+a.title,
+			fmt.Sprintf("Could not create %s %s: %s", a.kind, data.Name.ValueString(), err),
+		)
+		return diags
+	}
+
+	data.ID = apiString(result, "id")
+	return diags
+}
+
+func (a securityPolicyAPI) get(data *SnowflakeSecurityPolicyResourceModel) (map[string]interface{}, error) {
+	var policy map[string]interface{}
+	err := a.config.OVHClient.Get(fmt.Sprintf("%s/%s", a.endpoint, data.ID.ValueString()), &policy)
+	return policy, err
+}
+
+// read fetches the policy after a create or update.
+func (a securityPolicyAPI) read(data *SnowflakeSecurityPolicyResourceModel) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	policy, err := a.get(data)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Snowflake "+a.title,
+			fmt.Sprintf("Could not read %s %s: %s", a.kind, data.ID.ValueString(), err),
+		)
+		return nil, diags
+	}
+
+	data.refresh(policy)
+	return policy, diags
+}
+
+// update sends the settings that differ between plan and state, along with
+// the comment. Settings not sent keep their current value.
+func (a securityPolicyAPI) update(ctx context.Context, data, state *SnowflakeSecurityPolicyResourceModel, settings, stateSettings map[string]interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tflog.Debug(ctx, "Updating Snowflake "+a.kind, map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	updateConfig := map[string]interface{}{}
+	for key, value := range settings {
+		if !reflect.DeepEqual(value, stateSettings[key]) {
+			updateConfig[key] = value
+		}
+	}
+	if !data.Comment.Equal(state.Comment) {
+		updateConfig["comment"] = data.Comment.ValueString()
+	}
+
+	if len(updateConfig) == 0 {
+		return diags
+	}
+
+	err := a.config.OVHClient.Put(fmt.Sprintf("%s/%s", a.endpoint, data.ID.ValueString()), updateConfig, nil)
+	if err != nil {
+		diags.AddError(
+			"Error Updating Snowflake "+a.title,
+			fmt.Sprintf("Could not update %s %s: %s", a.kind, data.ID.ValueString(), err),
+		)
+	}
+	return diags
+}
+
+func (a securityPolicyAPI) delete(ctx context.Context, data *SnowflakeSecurityPolicyResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tflog.Debug(ctx, "Deleting Snowflake "+a.kind, map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	path := fmt.Sprintf("%s/%s", a.endpoint, data.ID.ValueString())
+
+	// Snowflake refuses to drop a policy that is attached to the account or
+	// to users; report where it is used instead of the generic SQL error.
+	references, err := policyReferences(a.config, path)
+	if isNotFoundError(err) {
+		return diags
+	}
+	if err != nil {
+		diags.AddError(
+			"Error Deleting Snowflake "+a.title,
+			fmt.Sprintf("Could not list references of %s %s: %s", a.kind, data.FullyQualifiedName.ValueString(), err),
+		)
+		return diags
+	}
+	if len(references) > 0 {
+		diags.AddError(
+			fmt.Sprintf("Snowflake %s Still In Use", a.title),
+			fmt.Sprintf("The %s %s is still attached to: %s. Remove the snowflake-ovh_security_policy_attachment resources for these before destroying the policy.",
+				a.kind, data.FullyQualifiedName.ValueString(), strings.Join(references, ", ")),
+		)
+		return diags
+	}
+
+	err = a.config.OVHClient.Delete(path, nil)
+	if err != nil && !isNotFoundError(err) {
+		diags.AddError(
+			"Error Deleting Snowflake "+a.title,
+			fmt.Sprintf("Could not delete %s %s: %s", a.kind, data.ID.ValueString(), err),
+		)
+	}
+	return diags
+}
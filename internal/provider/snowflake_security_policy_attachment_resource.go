@@ -0,0 +1,292 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/swcstudio/terraform-provider-ovh.snowflake/internal/sdk/identifiers"
+)
+
+var (
+	_ resource.Resource                = &SnowflakeSecurityPolicyAttachmentResource{}
+	_ resource.ResourceWithImportState = &SnowflakeSecurityPolicyAttachmentResource{}
+	_ resource.ResourceWithIdentity    = &SnowflakeSecurityPolicyAttachmentResource{}
+)
+
+var securityPolicyAttachmentIdentity = resourceIdentity{
+	{name: "policy_type", description: "Type of the attached policy."},
+	{name: "user", description: "User the policy is attached to, unset for the account.", optional: true},
+}
+
+func NewSnowflakeSecurityPolicyAttachmentResource() resource.Resource {
+	return &SnowflakeSecurityPolicyAttachmentResource{}
+}
+
+type SnowflakeSecurityPolicyAttachmentResource struct {
+	config *Config
+}
+
+type SnowflakeSecurityPolicyAttachmentResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	PolicyType types.String `tfsdk:"policy_type"`
+	Policy     types.String `tfsdk:"policy"`
+	User       types.String `tfsdk:"user"`
+}
+
+func (r *SnowflakeSecurityPolicyAttachmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_security_policy_attachment"
+}
+
+func (r *SnowflakeSecurityPolicyAttachmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Attaches a Snowflake password, session or authentication policy to the account or to a user on OVH infrastructure. The account and each user can have at most one policy of each type; a user-level policy takes precedence over the account-level one. Changing any argument detaches the policy and attaches it again.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Unique identifier for the attachment.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"policy_type": schema.StringAttribute{
+				Description: "Type of the policy: PASSWORD, SESSION or AUTHENTICATION.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					oneOfValidator{values: securityPolicyTypes},
+				},
+			},
+			"policy": schema.StringAttribute{
+				Description: "Fully qualified name of the policy.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					identifiers.ValidSchemaObjectIdentifier(),
+				},
+			},
+			"user": schema.StringAttribute{
+				Description: "User to attach the policy to. When omitted, the policy is attached to the account.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					identifiers.ValidName(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SnowflakeSecurityPolicyAttachmentResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = securityPolicyAttachmentIdentity.schema()
+}
+
+func (r *SnowflakeSecurityPolicyAttachmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.config = config
+}
+
+func (r *SnowflakeSecurityPolicyAttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SnowflakeSecurityPolicyAttachmentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Attaching Snowflake security policy", map[string]interface{}{
+		"policy_type": data.PolicyType.ValueString(),
+		"policy":      data.Policy.ValueString(),
+		"user":        data.User.ValueString(),
+	})
+
+	attachmentConfig := map[string]interface{}{
+		"policyType": data.PolicyType.ValueString(),
+		"policy":     data.Policy.ValueString(),
+		"user":       data.User.ValueString(),
+	}
+
+	var result map[string]interface{}
+	err := r.config.OVHClient.Post("/cloud/project/snowflake/security-policy-attachment", attachmentConfig, &result)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Snowflake Security Policy Attachment",
+			fmt.Sprintf("Could not attach %s policy %s to %s: %s",
+				strings.ToLower(data.PolicyType.ValueString()), data.Policy.ValueString(), data.target(), err),
+		)
+		return
+	}
+
+	data.ID = apiString(result, "id")
+
+	resp.Diagnostics.Append(r.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Attached Snowflake security policy")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(securityPolicyAttachmentIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
+}
+
+func (r *SnowflakeSecurityPolicyAttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SnowflakeSecurityPolicyAttachmentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Snowflake security policy attachment", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	var attachment map[string]interface{}
+	err := r.config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/security-policy-attachment/%s", data.ID.ValueString()), &attachment)
+	if isNotFoundError(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Snowflake Security Policy Attachment",
+			fmt.Sprintf("Could not read security policy attachment %s: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(data.refresh(ctx, attachment)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(securityPolicyAttachmentIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
+}
+
+// Update is never called with changes because every argument requires
+// replacement; it only carries the plan over to state.
+func (r *SnowflakeSecurityPolicyAttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SnowflakeSecurityPolicyAttachmentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(securityPolicyAttachmentIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
+}
+
+func (r *SnowflakeSecurityPolicyAttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SnowflakeSecurityPolicyAttachmentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Detaching Snowflake security policy", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.config.OVHClient.Delete(fmt.Sprintf("/cloud/project/snowflake/security-policy-attachment/%s", data.ID.ValueString()), nil)
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Snowflake Security Policy Attachment",
+			fmt.Sprintf("Could not detach %s policy %s from %s: %s",
+				strings.ToLower(data.PolicyType.ValueString()), data.Policy.ValueString(), data.target(), err),
+		)
+	}
+}
+
+// ImportState accepts IDs of the form policy_type for the account, or
+// policy_type|user for a user, as each has at most one policy of a type.
+func (r *SnowflakeSecurityPolicyAttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = securityPolicyAttachmentIdentity.importID(ctx, r.config, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if importByID(ctx, r.config, "security policy attachment", "/cloud/project/snowflake/security-policy-attachment", req.ID, resp) {
+		return
+	}
+
+	parts, err := splitImportID(req.ID, "policy_type or policy_type|user", 1, 2)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	filters := map[string]string{
+		"policyType": strings.ToUpper(parts[0]),
+		"user":       "",
+	}
+	if len(parts) == 2 {
+		filters["user"] = parts[1]
+	}
+
+	importByLookup(ctx, r.config, "security policy attachment", "/cloud/project/snowflake/security-policy-attachment", filters, resp)
+}
+
+// read refreshes data from the OVH API after a create.
+func (r *SnowflakeSecurityPolicyAttachmentResource) read(ctx context.Context, data *SnowflakeSecurityPolicyAttachmentResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var attachment map[string]interface{}
+	err := r.config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/security-policy-attachment/%s", data.ID.ValueString()), &attachment)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Snowflake Security Policy Attachment",
+			fmt.Sprintf("Could not read security policy attachment %s: %s", data.ID.ValueString(), err),
+		)
+		return diags
+	}
+
+	return data.refresh(ctx, attachment)
+}
+
+// target describes what the policy is attached to, for error messages.
+func (m *SnowflakeSecurityPolicyAttachmentResourceModel) target() string {
+	if m.User.IsNull() {
+		return "the account"
+	}
+	return "user " + m.User.ValueString()
+}
+
+// refresh copies an OVH API security policy attachment into the model.
+func (m *SnowflakeSecurityPolicyAttachmentResourceModel) refresh(ctx context.Context, attachment map[string]interface{}) diag.Diagnostics {
+	m.PolicyType = apiString(attachment, "policyType")
+	m.Policy = apiString(attachment, "policy")
+	m.User = apiOptionalString(attachment, "user")
+
+	return nil
+}
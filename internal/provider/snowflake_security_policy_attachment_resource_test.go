@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSnowflakeSecurityPolicyAttachmentResource_Create(t *testing.T) {
+	const (
+		create = "POST /cloud/project/snowflake/security-policy-attachment"
+		read   = "GET /cloud/project/snowflake/security-policy-attachment/1"
+	)
+
+	testCases := map[string]struct {
+		user       types.String
+		apiUser    string
+		wantTarget string
+	}{
+		"account": {user: types.StringNull(), apiUser: "", wantTarget: "the account"},
+		"user":    {user: types.StringValue("JSMITH"), apiUser: "JSMITH", wantTarget: "user JSMITH"},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			api, config := newFakeOVHAPI(t, map[string]interface{}{
+				create: map[string]interface{}{"id": "1"},
+				read: map[string]interface{}{
+					"id":         "1",
+					"policyType": "PASSWORD",
+					"policy":     "SECURITY.POLICIES.STRICT",
+					"user":       tc.apiUser,
+				},
+			})
+			r := &SnowflakeSecurityPolicyAttachmentResource{config: config}
+
+			schemaResp := &fwresource.SchemaResponse{}
+			r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+			plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+			diags := plan.Set(ctx, &SnowflakeSecurityPolicyAttachmentResourceModel{
+				ID:         types.StringUnknown(),
+				PolicyType: types.StringValue("PASSWORD"),
+				Policy:     types.StringValue("SECURITY.POLICIES.STRICT"),
+				User:       tc.user,
+			})
+			if diags.HasError() {
+				t.Fatalf("setting plan: %v", diags)
+			}
+
+			resp := &fwresource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: plan.Raw}}
+			r.Create(ctx, fwresource.CreateRequest{Plan: plan}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("create failed: %v", resp.Diagnostics)
+			}
+
+			if got := api.body(create)["user"]; got != tc.apiUser {
+				t.Errorf("user sent = %q, want %q", got, tc.apiUser)
+			}
+
+			var data SnowflakeSecurityPolicyAttachmentResourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("reading state: %v", resp.Diagnostics)
+			}
+			if !data.User.Equal(tc.user) {
+				t.Errorf("user = %s, want %s", data.User, tc.user)
+			}
+			if got := data.target(); got != tc.wantTarget {
+				t.Errorf("target() = %q, want %q", got, tc.wantTarget)
+			}
+		})
+	}
+}
+
+func TestSnowflakeSecurityPolicyAttachmentResource_ImportState(t *testing.T) {
+	testCases := map[string]struct {
+		id     string
+		lookup string
+	}{
+		"account": {id: "password", lookup: "GET /cloud/project/snowflake/security-policy-attachment?policyType=PASSWORD&user="},
+		"user":    {id: "password|JSMITH", lookup: "GET /cloud/project/snowflake/security-policy-attachment?policyType=PASSWORD&user=JSMITH"},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			_, config := newFakeOVHAPI(t, map[string]interface{}{
+				tc.lookup: []string{"1"},
+			})
+			r := &SnowflakeSecurityPolicyAttachmentResource{config: config}
+
+			schemaResp := &fwresource.SchemaResponse{}
+			r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+			resp := &fwresource.ImportStateResponse{
+				State: tfsdk.State{
+					Schema: schemaResp.Schema,
+					Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+				},
+			}
+
+			r.ImportState(ctx, fwresource.ImportStateRequest{ID: tc.id}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("import failed: %v", resp.Diagnostics)
+			}
+
+			var id types.String
+			resp.State.GetAttribute(ctx, path.Root("id"), &id)
+			if id.ValueString() != "1" {
+				t.Errorf("id = %s, want 1", id)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestInt64RangeValidator(t *testing.T) {
+	v := int64RangeValidator{min: 5, max: 240}
+
+	tests := []struct {
+		value   types.Int64
+		wantErr bool
+	}{
+		{types.Int64Value(5), false},
+		{types.Int64Value(240), false},
+		{types.Int64Value(4), true},
+		{types.Int64Value(241), true},
+		{types.Int64Null(), false},
+		{types.Int64Unknown(), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value.String(), func(t *testing.T) {
+			resp := &validator.Int64Response{}
+			v.ValidateInt64(context.Background(), validator.Int64Request{Path: path.Root("value"), ConfigValue: tt.value}, resp)
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Errorf("ValidateInt64(%s) errors = %v, wantErr %v", tt.value, resp.Diagnostics, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSessionPolicyRefreshSecondaryRoles(t *testing.T) {
+	ctx := context.Background()
+	policy := map[string]interface{}{"allowedSecondaryRoles": []interface{}{"ALL"}}
+
+	var unset SnowflakeSessionPolicyResourceModel
+	unset.AllowedSecondaryRoles = types.SetNull(types.StringType)
+	if diags := unset.refresh(ctx, policy); diags.HasError() {
+		t.Fatalf("refresh: %v", diags)
+	}
+	if !unset.AllowedSecondaryRoles.IsNull() {
+		t.Errorf("default secondary roles should stay null, got %s", unset.AllowedSecondaryRoles)
+	}
+
+	var none SnowflakeSessionPolicyResourceModel
+	none.AllowedSecondaryRoles = types.SetValueMust(types.StringType, nil)
+	if diags := none.refresh(ctx, policy); diags.HasError() {
+		t.Fatalf("refresh: %v", diags)
+	}
+	if len(none.AllowedSecondaryRoles.Elements()) != 1 {
+		t.Errorf("drift from an empty set should be reported, got %s", none.AllowedSecondaryRoles)
+	}
+}
+
+func TestAuthenticationPolicyRefreshDefaults(t *testing.T) {
+	ctx := context.Background()
+	policy := map[string]interface{}{
+		"mfaEnrollment":            "OPTIONAL",
+		"authenticationMethods":    []interface{}{"ALL"},
+		"clientTypes":              []interface{}{"SNOWFLAKE_UI", "DRIVERS"},
+		"securityIntegrations":     []interface{}{},
+		"mfaAuthenticationMethods": []interface{}{"SAML", "PASSWORD"},
+	}
+
+	// An imported policy starts out with every set null.
+	var imported SnowflakeAuthenticationPolicyResourceModel
+	for _, set := range imported.sets() {
+		*set = types.SetNull(types.StringType)
+	}
+	if diags := imported.refresh(ctx, policy); diags.HasError() {
+		t.Fatalf("refresh: %v", diags)
+	}
+
+	if !imported.AuthenticationMethods.IsNull() || !imported.SecurityIntegrations.IsNull() || !imported.MFAAuthenticationMethods.IsNull() {
+		t.Errorf("sets reporting their default should stay null, got %s, %s, %s",
+			imported.AuthenticationMethods, imported.SecurityIntegrations, imported.MFAAuthenticationMethods)
+	}
+	if len(imported.ClientTypes.Elements()) != 2 {
+		t.Errorf("configured client types should be imported, got %s", imported.ClientTypes)
+	}
+}
@@ -0,0 +1,255 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &SnowflakeSessionPolicyResource{}
+	_ resource.ResourceWithImportState = &SnowflakeSessionPolicyResource{}
+	_ resource.ResourceWithIdentity    = &SnowflakeSessionPolicyResource{}
+)
+
+var sessionPolicyIdentity = schemaObjectIdentity("session policy")
+
+func NewSnowflakeSessionPolicyResource() resource.Resource {
+	return &SnowflakeSessionPolicyResource{}
+}
+
+type SnowflakeSessionPolicyResource struct {
+	config *Config
+}
+
+type SnowflakeSessionPolicyResourceModel struct {
+	SnowflakeSecurityPolicyResourceModel
+
+	IdleTimeoutMins       types.Int64 `tfsdk:"idle_timeout_mins"`
+	UIIdleTimeoutMins     types.Int64 `tfsdk:"ui_idle_timeout_mins"`
+	AllowedSecondaryRoles types.Set   `tfsdk:"allowed_secondary_roles"`
+}
+
+func (r *SnowflakeSessionPolicyResource) api() securityPolicyAPI {
+	return securityPolicyAPI{
+		config:   r.config,
+		endpoint: "/cloud/project/snowflake/session-policy",
+		kind:     "session policy",
+		title:    "Session Policy",
+	}
+}
+
+func (r *SnowflakeSessionPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_session_policy"
+}
+
+func (r *SnowflakeSessionPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := securityPolicyAttributes("session policy")
+
+	attributes["idle_timeout_mins"] = policyInt64Attribute("Minutes of inactivity after which a session from a driver or connector ends.", 240, 5, 240)
+	attributes["ui_idle_timeout_mins"] = policyInt64Attribute("Minutes of inactivity after which a Snowsight session ends.", 240, 5, 240)
+	attributes["allowed_secondary_roles"] = schema.SetAttribute{
+		Description: "Roles that can be activated as secondary roles in a session. Use [\"ALL\"] for every granted role or [] for none. When omitted, Snowflake allows all roles.",
+		ElementType: types.StringType,
+		Optional:    true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a Snowflake session policy on OVH infrastructure, controlling idle session timeouts. Attach it to the account or to users with snowflake-ovh_security_policy_attachment.",
+		Attributes:  attributes,
+	}
+}
+
+func (r *SnowflakeSessionPolicyResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = sessionPolicyIdentity.schema()
+}
+
+func (r *SnowflakeSessionPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.config = config
+}
+
+func (r *SnowflakeSessionPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SnowflakeSessionPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, diags := data.settings(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.api().create(ctx, &data.SnowflakeSecurityPolicyResourceModel, settings)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, diags := r.api().read(&data.SnowflakeSecurityPolicyResourceModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(data.refresh(ctx, policy)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Created Snowflake session policy")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(sessionPolicyIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
+}
+
+func (r *SnowflakeSessionPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SnowflakeSessionPolicyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Snowflake session policy", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	policy, err := r.api().get(&data.SnowflakeSecurityPolicyResourceModel)
+	if isNotFoundError(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Snowflake Session Policy",
+			fmt.Sprintf("Could not read session policy %s: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	data.SnowflakeSecurityPolicyResourceModel.refresh(policy)
+	resp.Diagnostics.Append(data.refresh(ctx, policy)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(sessionPolicyIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
+}
+
+func (r *SnowflakeSessionPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state SnowflakeSessionPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, diags := data.settings(ctx)
+	resp.Diagnostics.Append(diags...)
+	stateSettings, diags := state.settings(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.api().update(ctx, &data.SnowflakeSecurityPolicyResourceModel, &state.SnowflakeSecurityPolicyResourceModel,
+		settings, stateSettings)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, diags := r.api().read(&data.SnowflakeSecurityPolicyResourceModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(data.refresh(ctx, policy)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(sessionPolicyIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
+}
+
+func (r *SnowflakeSessionPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SnowflakeSessionPolicyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.api().delete(ctx, &data.SnowflakeSecurityPolicyResourceModel)...)
+}
+
+func (r *SnowflakeSessionPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = sessionPolicyIdentity.importID(ctx, r.config, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	importSchemaObject(ctx, r.config, r.api().kind, r.api().endpoint, req, resp)
+}
+
+// settings returns the session policy settings for the OVH API. A null
+// allowed_secondary_roles is sent as nil so that the API restores the
+// default.
+func (m *SnowflakeSessionPolicyResourceModel) settings(ctx context.Context) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var roles []string
+	if !m.AllowedSecondaryRoles.IsNull() {
+		var values []types.String
+		diags.Append(m.AllowedSecondaryRoles.ElementsAs(ctx, &values, false)...)
+		roles = stringValues(values)
+	}
+
+	return map[string]interface{}{
+		"sessionIdleTimeoutMins":   m.IdleTimeoutMins.ValueInt64(),
+		"sessionUiIdleTimeoutMins": m.UIIdleTimeoutMins.ValueInt64(),
+		"allowedSecondaryRoles":    roles,
+	}, diags
+}
+
+// refresh copies the session policy settings of an OVH API policy into the
+// model. The default of allowing all secondary roles is kept null when
+// allowed_secondary_roles is not configured.
+func (m *SnowflakeSessionPolicyResourceModel) refresh(ctx context.Context, policy map[string]interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.IdleTimeoutMins = apiInt64(policy, "sessionIdleTimeoutMins")
+	m.UIIdleTimeoutMins = apiInt64(policy, "sessionUiIdleTimeoutMins")
+
+	roles := stringValues(apiStringList(policy, "allowedSecondaryRoles"))
+	if m.AllowedSecondaryRoles.IsNull() && len(roles) == 1 && roles[0] == "ALL" {
+		return diags
+	}
+
+	set, d := types.SetValueFrom(ctx, types.StringType, roles)
+	diags.Append(d...)
+	m.AllowedSecondaryRoles = set
+
+	return diags
+}
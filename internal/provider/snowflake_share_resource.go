@@ -0,0 +1,568 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/swcstudio/terraform-provider-ovh.snowflake/internal/sdk/identifiers"
+)
+
+var (
+	_ resource.Resource                   = &SnowflakeShareResource{}
+	_ resource.ResourceWithValidateConfig = &SnowflakeShareResource{}
+	_ resource.ResourceWithImportState    = &SnowflakeShareResource{}
+	_ resource.ResourceWithIdentity       = &SnowflakeShareResource{}
+)
+
+var shareIdentity = accountObjectIdentity("share")
+
+func NewSnowflakeShareResource() resource.Resource {
+	return &SnowflakeShareResource{}
+}
+
+type SnowflakeShareResource struct {
+	config *Config
+}
+
+type SnowflakeShareResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Comment   types.String `tfsdk:"comment"`
+	Accounts  types.Set    `tfsdk:"accounts"`
+	Grants    types.Set    `tfsdk:"grants"`
+	Owner     types.String `tfsdk:"owner"`
+	CreatedOn types.String `tfsdk:"created_on"`
+}
+
+// SnowflakeShareGrantModel describes a privilege granted to a share.
+type SnowflakeShareGrantModel struct {
+	Privilege  types.String `tfsdk:"privilege"`
+	ObjectType types.String `tfsdk:"object_type"`
+	ObjectName types.String `tfsdk:"object_name"`
+}
+
+var shareGrantAttrTypes = map[string]attr.Type{
+	"privilege":   types.StringType,
+	"object_type": types.StringType,
+	"object_name": types.StringType,
+}
+
+// sharePrivileges lists the privileges that can be granted to a share for
+// each object type.
+var sharePrivileges = map[string][]string{
+	"DATABASE": {"USAGE", "REFERENCE_USAGE"},
+	"SCHEMA":   {"USAGE"},
+	"TABLE":    {"SELECT"},
+	"VIEW":     {"SELECT"},
+}
+
+// key identifies a grant regardless of how its object name is cased.
+func (g SnowflakeShareGrantModel) key() string {
+	return strings.ToUpper(g.Privilege.ValueString() + " " + g.ObjectType.ValueString() + " " + g.ObjectName.ValueString())
+}
+
+// payload returns the OVH API representation of the grant.
+func (g SnowflakeShareGrantModel) payload() map[string]interface{} {
+	return map[string]interface{}{
+		"privilege":  g.Privilege.ValueString(),
+		"objectType": g.ObjectType.ValueString(),
+		"objectName": g.ObjectName.ValueString(),
+	}
+}
+
+// diffShareGrants returns the grants in planned but not in current, and
+// those in current but not in planned, each sorted for a stable apply order.
+func diffShareGrants(current, planned []SnowflakeShareGrantModel) ([]SnowflakeShareGrantModel, []SnowflakeShareGrantModel) {
+	index := func(grants []SnowflakeShareGrantModel) map[string]SnowflakeShareGrantModel {
+		result := make(map[string]SnowflakeShareGrantModel, len(grants))
+		for _, grant := range grants {
+			result[grant.key()] = grant
+		}
+		return result
+	}
+	currentByKey, plannedByKey := index(current), index(planned)
+
+	var add, revoke []SnowflakeShareGrantModel
+	for key, grant := range plannedByKey {
+		if _, ok := currentByKey[key]; !ok {
+			add = append(add, grant)
+		}
+	}
+	for key, grant := range currentByKey {
+		if _, ok := plannedByKey[key]; !ok {
+			revoke = append(revoke, grant)
+		}
+	}
+
+	// Containers are granted before their contents and revoked after them.
+	rank := map[string]int{"DATABASE": 0, "SCHEMA": 1, "TABLE": 2, "VIEW": 2}
+	sort.Slice(add, func(i, j int) bool {
+		ri, rj := rank[add[i].ObjectType.ValueString()], rank[add[j].ObjectType.ValueString()]
+		if ri != rj {
+			return ri < rj
+		}
+		return add[i].key() < add[j].key()
+	})
+	sort.Slice(revoke, func(i, j int) bool {
+		ri, rj := rank[revoke[i].ObjectType.ValueString()], rank[revoke[j].ObjectType.ValueString()]
+		if ri != rj {
+			return ri > rj
+		}
+		return revoke[i].key() < revoke[j].key()
+	})
+
+	return add, revoke
+}
+
+func (r *SnowflakeShareResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_share"
+}
+
+func (r *SnowflakeShareResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	objectTypes := make([]string, 0, len(sharePrivileges))
+	for objectType := range sharePrivileges {
+		objectTypes = append(objectTypes, objectType)
+	}
+	sort.Strings(objectTypes)
+
+	resp.Schema = schema.Schema{
+		Description: "Manages an outbound Snowflake share on OVH infrastructure, including the privileges granted to it and the consumer accounts it is shared with. Grants are applied before consumer accounts are added and revoked after they are removed.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Unique identifier for the share.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the share.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					identifiers.ValidName(),
+				},
+			},
+			"comment": schema.StringAttribute{
+				Description: "Comment for the share.",
+				Optional:    true,
+			},
+			"accounts": schema.SetAttribute{
+				Description: "Consumer accounts the share is available to, as organization.account identifiers.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"grants": schema.SetNestedAttribute{
+				Description: "Privileges granted to the share. Sharing with consumer accounts requires USAGE on exactly one database.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"privilege": schema.StringAttribute{
+							Description: "Privilege to grant: USAGE or REFERENCE_USAGE on a database, USAGE on a schema, SELECT on a table or view.",
+							Required:    true,
+						},
+						"object_type": schema.StringAttribute{
+							Description: fmt.Sprintf("Type of the shared object (%s).", strings.Join(objectTypes, ", ")),
+							Required:    true,
+							Validators: []validator.String{
+								oneOfValidator{values: objectTypes},
+							},
+						},
+						"object_name": schema.StringAttribute{
+							Description: "Fully qualified name of the shared object.",
+							Required:    true,
+						},
+					},
+				},
+			},
+			"owner": schema.StringAttribute{
+				Description: "Role that owns the share.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_on": schema.StringAttribute{
+				Description: "Creation timestamp of the share.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SnowflakeShareResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = shareIdentity.schema()
+}
+
+func (r *SnowflakeShareResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data SnowflakeShareResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Grants.IsUnknown() {
+		return
+	}
+
+	var grants []SnowflakeShareGrantModel
+	if !data.Grants.IsNull() {
+		resp.Diagnostics.Append(data.Grants.ElementsAs(ctx, &grants, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	databases := map[string]bool{}
+	unknownDatabase := false
+	for _, grant := range grants {
+		if grant.Privilege.IsUnknown() || grant.ObjectType.IsUnknown() {
+			continue
+		}
+		objectType := grant.ObjectType.ValueString()
+		allowed, ok := sharePrivileges[objectType]
+		if ok && !stringInSlice(grant.Privilege.ValueString(), allowed) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("grants"),
+				"Invalid Share Privilege",
+				fmt.Sprintf("%s cannot be granted on a %s to a share; allowed privileges: %s.",
+					grant.Privilege.ValueString(), objectType, strings.Join(allowed, ", ")),
+			)
+		}
+		if objectType == "DATABASE" && grant.Privilege.ValueString() == "USAGE" {
+			if grant.ObjectName.IsUnknown() {
+				unknownDatabase = true
+				continue
+			}
+			databases[strings.ToUpper(grant.ObjectName.ValueString())] = true
+		}
+	}
+
+	if len(databases) > 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("grants"),
+			"Too Many Shared Databases",
+			"A share can grant USAGE on only one database; use REFERENCE_USAGE for databases referenced by shared secure views.",
+		)
+	}
+	if len(databases) == 0 && !unknownDatabase && !data.Accounts.IsUnknown() && len(data.Accounts.Elements()) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("accounts"),
+			"Missing Database Grant",
+			"Consumer accounts can only be added to a share that grants USAGE on a database.",
+		)
+	}
+}
+
+func (r *SnowflakeShareResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.config = config
+}
+
+func (r *SnowflakeShareResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SnowflakeShareResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating Snowflake share", map[string]interface{}{
+		"name": data.Name.ValueString(),
+	})
+
+	shareConfig := map[string]interface{}{
+		"name":    data.Name.ValueString(),
+		"comment": data.Comment.ValueString(),
+	}
+
+	var result map[string]interface{}
+	err := r.config.OVHClient.Post("/cloud/project/snowflake/share", shareConfig, &result)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Snowflake Share",
+			fmt.Sprintf("Could not create share %s: %s", data.Name.ValueString(), err),
+		)
+		return
+	}
+
+	data.ID = apiString(result, "id")
+
+	// Record the share before granting so a failed grant does not orphan it.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), data.ID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &data, types.SetNull(types.StringType), types.SetNull(types.ObjectType{AttrTypes: shareGrantAttrTypes}))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Created Snowflake share")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(shareIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
+}
+
+func (r *SnowflakeShareResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SnowflakeShareResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Snowflake share", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	var share map[string]interface{}
+	err := r.config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/share/%s", data.ID.ValueString()), &share)
+	if isNotFoundError(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Snowflake Share",
+			fmt.Sprintf("Could not read share %s: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(data.refresh(ctx, share)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(shareIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
+}
+
+func (r *SnowflakeShareResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state SnowflakeShareResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating Snowflake share", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	if !data.Comment.Equal(state.Comment) {
+		updateConfig := map[string]interface{}{
+			"comment": data.Comment.ValueString(),
+		}
+
+		err := r.config.OVHClient.Put(fmt.Sprintf("/cloud/project/snowflake/share/%s", data.ID.ValueString()), updateConfig, nil)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Snowflake Share",
+				fmt.Sprintf("Could not update share %s: %s", data.ID.ValueString(), err),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &data, state.Accounts, state.Grants)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(shareIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
+}
+
+func (r *SnowflakeShareResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SnowflakeShareResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting Snowflake share", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.config.OVHClient.Delete(fmt.Sprintf("/cloud/project/snowflake/share/%s", data.ID.ValueString()), nil)
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Snowflake Share",
+			fmt.Sprintf("Could not delete share %s: %s", data.ID.ValueString(), err),
+		)
+	}
+}
+
+func (r *SnowflakeShareResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = shareIdentity.importID(ctx, r.config, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	importAccountObject(ctx, r.config, "share", "/cloud/project/snowflake/share", req, resp)
+}
+
+// apply moves the share's grants and consumer accounts from the current
+// values to those in data: new grants first, then accounts, then revocations,
+// so consumers never see a share without its database.
+func (r *SnowflakeShareResource) apply(ctx context.Context, data *SnowflakeShareResourceModel, currentAccounts, currentGrants types.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var current, planned []SnowflakeShareGrantModel
+	if !currentGrants.IsNull() {
+		diags.Append(currentGrants.ElementsAs(ctx, &current, false)...)
+	}
+	if !data.Grants.IsNull() {
+		diags.Append(data.Grants.ElementsAs(ctx, &planned, false)...)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	add, revoke := diffShareGrants(current, planned)
+	sharePath := fmt.Sprintf("/cloud/project/snowflake/share/%s", data.ID.ValueString())
+
+	for _, grant := range add {
+		tflog.Debug(ctx, "Granting privilege to Snowflake share", grant.payload())
+		if err := r.config.OVHClient.Post(sharePath+"/grant", grant.payload(), nil); err != nil {
+			diags.AddError(
+				"Error Granting Privilege to Snowflake Share",
+				fmt.Sprintf("Could not grant %s on %s %s to share %s: %s", grant.Privilege.ValueString(),
+					grant.ObjectType.ValueString(), grant.ObjectName.ValueString(), data.Name.ValueString(), err),
+			)
+			return diags
+		}
+	}
+
+	if !data.Accounts.Equal(currentAccounts) {
+		var accounts []types.String
+		if !data.Accounts.IsNull() {
+			diags.Append(data.Accounts.ElementsAs(ctx, &accounts, false)...)
+			if diags.HasError() {
+				return diags
+			}
+		}
+
+		updateConfig := map[string]interface{}{
+			"accounts": stringValues(accounts),
+		}
+		if err := r.config.OVHClient.Put(sharePath, updateConfig, nil); err != nil {
+			diags.AddError(
+				"Error Updating Snowflake Share",
+				fmt.Sprintf("Could not set consumer accounts of share %s: %s", data.Name.ValueString(), err),
+			)
+			return diags
+		}
+	}
+
+	for _, grant := range revoke {
+		tflog.Debug(ctx, "Revoking privilege from Snowflake share", grant.payload())
+		if err := r.config.OVHClient.Post(sharePath+"/revoke", grant.payload(), nil); err != nil {
+			diags.AddError(
+				"Error Revoking Privilege from Snowflake Share",
+				fmt.Sprintf("Could not revoke %s on %s %s from share %s: %s", grant.Privilege.ValueString(),
+					grant.ObjectType.ValueString(), grant.ObjectName.ValueString(), data.Name.ValueString(), err),
+			)
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// read refreshes data from the OVH API after a create or update.
+func (r *SnowflakeShareResource) read(ctx context.Context, data *SnowflakeShareResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var share map[string]interface{}
+	err := r.config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/share/%s", data.ID.ValueString()), &share)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Snowflake Share",
+			fmt.Sprintf("Could not read share %s: %s", data.ID.ValueString(), err),
+		)
+		return diags
+	}
+
+	return data.refresh(ctx, share)
+}
+
+// refresh copies an OVH API share into the model. Empty account and grant
+// lists are kept null when they were not configured.
+func (m *SnowflakeShareResourceModel) refresh(ctx context.Context, share map[string]interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.Name = apiString(share, "name")
+	m.Comment = apiOptionalString(share, "comment")
+	m.Owner = apiString(share, "owner")
+	m.CreatedOn = apiString(share, "createdOn")
+
+	if accounts := apiStringList(share, "accounts"); len(accounts) > 0 || !m.Accounts.IsNull() {
+		set, d := types.SetValueFrom(ctx, types.StringType, accounts)
+		diags.Append(d...)
+		m.Accounts = set
+	}
+
+	raw, _ := share["grants"].([]interface{})
+	grants := make([]SnowflakeShareGrantModel, 0, len(raw))
+	for _, item := range raw {
+		grant, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		grants = append(grants, SnowflakeShareGrantModel{
+			Privilege:  apiString(grant, "privilege"),
+			ObjectType: apiString(grant, "objectType"),
+			ObjectName: apiString(grant, "objectName"),
+		})
+	}
+	if len(grants) > 0 || !m.Grants.IsNull() {
+		set, d := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: shareGrantAttrTypes}, grants)
+		diags.Append(d...)
+		m.Grants = set
+	}
+
+	return diags
+}
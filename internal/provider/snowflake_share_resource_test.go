@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDiffShareGrants(t *testing.T) {
+	grant := func(privilege, objectType, objectName string) SnowflakeShareGrantModel {
+		return SnowflakeShareGrantModel{
+			Privilege:  types.StringValue(privilege),
+			ObjectType: types.StringValue(objectType),
+			ObjectName: types.StringValue(objectName),
+		}
+	}
+	keys := func(grants []SnowflakeShareGrantModel) []string {
+		result := []string{}
+		for _, g := range grants {
+			result = append(result, g.key())
+		}
+		return result
+	}
+
+	current := []SnowflakeShareGrantModel{
+		grant("USAGE", "DATABASE", "sales"),
+		grant("USAGE", "SCHEMA", "sales.public"),
+		grant("SELECT", "TABLE", "sales.public.orders"),
+	}
+	planned := []SnowflakeShareGrantModel{
+		grant("SELECT", "VIEW", "analytics.public.summary"),
+		grant("USAGE", "SCHEMA", "analytics.public"),
+		grant("USAGE", "DATABASE", "ANALYTICS"),
+		grant("SELECT", "TABLE", "SALES.PUBLIC.ORDERS"),
+	}
+
+	add, revoke := diffShareGrants(current, planned)
+
+	expectedAdd := []string{"USAGE DATABASE ANALYTICS", "USAGE SCHEMA ANALYTICS.PUBLIC", "SELECT VIEW ANALYTICS.PUBLIC.SUMMARY"}
+	expectedRevoke := []string{"USAGE SCHEMA SALES.PUBLIC", "USAGE DATABASE SALES"}
+
+	if got := keys(add); !reflect.DeepEqual(got, expectedAdd) {
+		t.Errorf("expected grants %v, got %v", expectedAdd, got)
+	}
+	if got := keys(revoke); !reflect.DeepEqual(got, expectedRevoke) {
+		t.Errorf("expected revocations %v, got %v", expectedRevoke, got)
+	}
+}
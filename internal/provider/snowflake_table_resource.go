@@ -4,13 +4,27 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/swcstudio/terraform-provider-ovh.snowflake/internal/sdk/identifiers"
+)
+
+var (
+	_ resource.Resource                 = &SnowflakeTableResource{}
+	_ resource.ResourceWithImportState  = &SnowflakeTableResource{}
+	_ resource.ResourceWithIdentity     = &SnowflakeTableResource{}
+	_ resource.ResourceWithMoveState    = &SnowflakeTableResource{}
+	_ resource.ResourceWithUpgradeState = &SnowflakeTableResource{}
 )
 
-var _ resource.Resource = &SnowflakeTableResource{}
+var tableIdentity = schemaObjectIdentity("table")
 
 func NewSnowflakeTableResource() resource.Resource {
 	return &SnowflakeTableResource{}
@@ -35,22 +49,44 @@ func (r *SnowflakeTableResource) Metadata(ctx context.Context, req resource.Meta
 func (r *SnowflakeTableResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages a Snowflake table on OVH infrastructure.",
+		Version:     1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "Unique identifier for the table.",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"name": schema.StringAttribute{
 				Description: "Name of the table.",
 				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					identifiers.ValidName(),
+				},
 			},
 			"database": schema.StringAttribute{
 				Description: "Database that contains the table.",
 				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					identifiers.ValidName(),
+				},
 			},
 			"schema": schema.StringAttribute{
 				Description: "Schema that contains the table.",
 				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					identifiers.ValidName(),
+				},
 			},
 			"comment": schema.StringAttribute{
 				Description: "Comment for the table.",
@@ -60,6 +96,10 @@ func (r *SnowflakeTableResource) Schema(ctx context.Context, req resource.Schema
 	}
 }
 
+func (r *SnowflakeTableResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = tableIdentity.schema()
+}
+
 func (r *SnowflakeTableResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -91,11 +131,34 @@ func (r *SnowflakeTableResource) Create(ctx context.Context, req resource.Create
 		"schema":   data.Schema.ValueString(),
 	})
 
-	data.ID = types.StringValue(fmt.Sprintf("table-%s-%s-%s", data.Database.ValueString(), data.Schema.ValueString(), data.Name.ValueString()))
+	tableConfig := map[string]interface{}{
+		"name":     data.Name.ValueString(),
+		"database": data.Database.ValueString(),
+		"schema":   data.Schema.ValueString(),
+		"comment":  data.Comment.ValueString(),
+	}
+
+	var result map[string]interface{}
+	err := r.config.OVHClient.Post("/cloud/project/snowflake/table", tableConfig, &result)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Snowflake Table",
+			fmt.Sprintf("Could not create table %s: %s", data.Name.ValueString(), err),
+		)
+		return
+	}
+
+	data.ID = apiString(result, "id")
+
+	resp.Diagnostics.Append(r.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	tflog.Trace(ctx, "Created Snowflake table")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(tableIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
 }
 
 func (r *SnowflakeTableResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -110,13 +173,40 @@ func (r *SnowflakeTableResource) Read(ctx context.Context, req resource.ReadRequ
 		"id": data.ID.ValueString(),
 	})
 
+	resp.Diagnostics.Append(resolveMissingID(r.config, "table", "/cloud/project/snowflake/table", map[string]string{
+		"database": data.Database.ValueString(),
+		"schema":   data.Schema.ValueString(),
+		"name":     data.Name.ValueString(),
+	}, &data.ID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var table map[string]interface{}
+	err := r.config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/table/%s", data.ID.ValueString()), &table)
+	if isNotFoundError(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Snowflake Table",
+			fmt.Sprintf("Could not read table %s: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	data.refresh(table)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(tableIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
 }
 
 func (r *SnowflakeTableResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data SnowflakeTableResourceModel
+	var data, state SnowflakeTableResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -125,7 +215,27 @@ func (r *SnowflakeTableResource) Update(ctx context.Context, req resource.Update
 		"id": data.ID.ValueString(),
 	})
 
+	if !data.Comment.Equal(state.Comment) {
+		updateConfig := map[string]interface{}{
+			"comment": data.Comment.ValueString(),
+		}
+		err := r.config.OVHClient.Put(fmt.Sprintf("/cloud/project/snowflake/table/%s", data.ID.ValueString()), updateConfig, nil)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Snowflake Table",
+				fmt.Sprintf("Could not update table %s: %s", data.ID.ValueString(), err),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(tableIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
 }
 
 func (r *SnowflakeTableResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -139,4 +249,108 @@ func (r *SnowflakeTableResource) Delete(ctx context.Context, req resource.Delete
 	tflog.Debug(ctx, "Deleting Snowflake table", map[string]interface{}{
 		"id": data.ID.ValueString(),
 	})
+
+	err := r.config.OVHClient.Delete(fmt.Sprintf("/cloud/project/snowflake/table/%s", data.ID.ValueString()), nil)
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Snowflake Table",
+			fmt.Sprintf("Could not delete table %s: %s", data.ID.ValueString(), err),
+		)
+	}
+}
+
+func (r *SnowflakeTableResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = tableIdentity.importID(ctx, r.config, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	importSchemaObject(ctx, r.config, "table", "/cloud/project/snowflake/table", req, resp)
+}
+
+// MoveState moves tables from the upstream Snowflake provider. Columns and
+// the other table settings are not part of this resource and are dropped.
+func (r *SnowflakeTableResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		upstreamStateMover([]string{"snowflake_table"}, func(ctx context.Context, sourceType string, source upstreamState, resp *resource.MoveStateResponse) {
+			data := SnowflakeTableResourceModel{
+				Name:     source.string("name"),
+				Database: source.string("database"),
+				Schema:   source.string("schema"),
+				Comment:  source.string("comment"),
+			}
+
+			resp.Diagnostics.Append(resp.TargetState.Set(ctx, &data)...)
+		}),
+	}
+}
+
+// snowflakeTableResourceModelV0 is the state of the table at version 0.
+type snowflakeTableResourceModelV0 struct {
+	ID       types.String `tfsdk:"id"`
+	Name     types.String `tfsdk:"name"`
+	Database types.String `tfsdk:"database"`
+	Schema   types.String `tfsdk:"schema"`
+	Comment  types.String `tfsdk:"comment"`
+}
+
+// UpgradeState upgrades state from version 0, which stored a placeholder
+// rather than the OVH ID of the table.
+func (r *SnowflakeTableResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":       schema.StringAttribute{Computed: true},
+					"name":     schema.StringAttribute{Required: true},
+					"database": schema.StringAttribute{Required: true},
+					"schema":   schema.StringAttribute{Required: true},
+					"comment":  schema.StringAttribute{Optional: true},
+				},
+			},
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var prior snowflakeTableResourceModelV0
+				resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				data := SnowflakeTableResourceModel{
+					ID:       upgradeStubID(prior.ID, "table-%s-%s-%s", prior.Database, prior.Schema, prior.Name),
+					Name:     prior.Name,
+					Database: prior.Database,
+					Schema:   prior.Schema,
+					Comment:  prior.Comment,
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			},
+		},
+	}
+}
+
+// read refreshes data from the OVH API after a create or update.
+func (r *SnowflakeTableResource) read(ctx context.Context, data *SnowflakeTableResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var table map[string]interface{}
+	err := r.config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/table/%s", data.ID.ValueString()), &table)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Snowflake Table",
+			fmt.Sprintf("Could not read table %s: %s", data.ID.ValueString(), err),
+		)
+		return diags
+	}
+
+	data.refresh(table)
+	return diags
+}
+
+// refresh copies an OVH API table into the model.
+func (m *SnowflakeTableResourceModel) refresh(table map[string]interface{}) {
+	m.Name = apiString(table, "name")
+	m.Database = apiString(table, "database")
+	m.Schema = apiString(table, "schema")
+	m.Comment = apiOptionalString(table, "comment")
 }
@@ -0,0 +1,439 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/swcstudio/terraform-provider-ovh.snowflake/internal/sdk/identifiers"
+)
+
+var (
+	_ resource.Resource                   = &SnowflakeTagAssociationResource{}
+	_ resource.ResourceWithValidateConfig = &SnowflakeTagAssociationResource{}
+	_ resource.ResourceWithModifyPlan     = &SnowflakeTagAssociationResource{}
+	_ resource.ResourceWithImportState    = &SnowflakeTagAssociationResource{}
+	_ resource.ResourceWithIdentity       = &SnowflakeTagAssociationResource{}
+)
+
+var tagAssociationIdentity = resourceIdentity{
+	{name: "tag", description: "Fully qualified name of the tag."},
+	{name: "object_type", description: "Type of the tagged object."},
+	{name: "object_name", description: "Fully qualified name of the tagged object."},
+	{name: "column", description: "Tagged column, unset when the tag is on the object itself.", optional: true},
+}
+
+func NewSnowflakeTagAssociationResource() resource.Resource {
+	return &SnowflakeTagAssociationResource{}
+}
+
+type SnowflakeTagAssociationResource struct {
+	config *Config
+}
+
+type SnowflakeTagAssociationResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Tag        types.String `tfsdk:"tag"`
+	Value      types.String `tfsdk:"value"`
+	ObjectType types.String `tfsdk:"object_type"`
+	ObjectName types.String `tfsdk:"object_name"`
+	Column     types.String `tfsdk:"column"`
+}
+
+// taggableObjectTypes are the object types a tag can be set on.
+var taggableObjectTypes = []string{
+	"ACCOUNT", "DATABASE", "SCHEMA", "TABLE", "EXTERNAL TABLE", "VIEW",
+	"MATERIALIZED VIEW", "DYNAMIC TABLE", "WAREHOUSE", "ROLE", "USER",
+	"STAGE", "STREAM", "TASK", "PIPE", "FUNCTION", "PROCEDURE",
+	"MASKING POLICY", "ROW ACCESS POLICY", "NETWORK POLICY", "SHARE",
+}
+
+// columnObjectTypes are the object types whose columns can be tagged.
+var columnObjectTypes = []string{"TABLE", "EXTERNAL TABLE", "VIEW", "MATERIALIZED VIEW", "DYNAMIC TABLE"}
+
+// tagValueAllowed reports whether value satisfies a tag's allowed values.
+// Allowed values are case-sensitive, and an empty list allows any value.
+func tagValueAllowed(allowedValues []types.String, value string) bool {
+	if len(allowedValues) == 0 {
+		return true
+	}
+	for _, allowed := range allowedValues {
+		if allowed.ValueString() == value {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *SnowflakeTagAssociationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tag_association"
+}
+
+func (r *SnowflakeTagAssociationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Sets a Snowflake tag on an object or column on OVH infrastructure. When the tag already exists, the value is checked against its allowed values at plan time.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Unique identifier for the tag association.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tag": schema.StringAttribute{
+				Description: "Fully qualified name of the tag.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					identifiers.ValidSchemaObjectIdentifier(),
+				},
+			},
+			"value": schema.StringAttribute{
+				Description: "Value of the tag on the object. Must be one of the tag's allowed values when it has any.",
+				Required:    true,
+			},
+			"object_type": schema.StringAttribute{
+				Description: fmt.Sprintf("Type of the tagged object (%s).", strings.Join(taggableObjectTypes, ", ")),
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					oneOfValidator{values: taggableObjectTypes},
+				},
+			},
+			"object_name": schema.StringAttribute{
+				Description: "Fully qualified name of the tagged object. Required unless object_type is ACCOUNT.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"column": schema.StringAttribute{
+				Description: "Column to tag instead of the object itself. Only valid for tables and views.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SnowflakeTagAssociationResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = tagAssociationIdentity.schema()
+}
+
+func (r *SnowflakeTagAssociationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data SnowflakeTagAssociationResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.ObjectType.IsUnknown() {
+		return
+	}
+
+	objectType := data.ObjectType.ValueString()
+
+	if objectType != "ACCOUNT" && data.ObjectName.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("object_name"),
+			"Missing Object Name",
+			fmt.Sprintf("object_name is required when object_type is %s.", objectType),
+		)
+	}
+	if objectType == "ACCOUNT" && !data.ObjectName.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("object_name"),
+			"Invalid Object Name",
+			"object_name must not be set when object_type is ACCOUNT; the current account is tagged.",
+		)
+	}
+	if !data.Column.IsNull() && !stringInSlice(objectType, columnObjectTypes) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("column"),
+			"Invalid Column",
+			fmt.Sprintf("Columns can only be tagged on %s objects, not %s.", strings.Join(columnObjectTypes, ", "), objectType),
+		)
+	}
+}
+
+func (r *SnowflakeTagAssociationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.config == nil {
+		return
+	}
+
+	var plan SnowflakeTagAssociationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Tag.IsUnknown() || plan.Value.IsUnknown() {
+		return
+	}
+
+	// Tags created in the same apply are not found yet; Create checks them.
+	// Any other failure to read a known tag would only resurface on apply.
+	tag, err := lookupTag(r.config, plan.Tag.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("tag"),
+			"Error Reading Snowflake Tag",
+			fmt.Sprintf("Could not read tag %s to check its allowed values: %s", plan.Tag.ValueString(), err),
+		)
+		return
+	}
+	if tag != nil {
+		resp.Diagnostics.Append(validateTagValue(tag, plan.Tag.ValueString(), plan.Value.ValueString())...)
+	}
+}
+
+// validateTagValue reports an error when value is not one of the allowed
+// values of tag.
+func validateTagValue(tag map[string]interface{}, tagName, value string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	allowedValues := apiStringList(tag, "allowedValues")
+	if !tagValueAllowed(allowedValues, value) {
+		diags.AddAttributeError(
+			path.Root("value"),
+			"Tag Value Not Allowed",
+			fmt.Sprintf("%q is not an allowed value of tag %s. Allowed values: %s.",
+				value, tagName, strings.Join(stringValues(allowedValues), ", ")),
+		)
+	}
+	return diags
+}
+
+func (r *SnowflakeTagAssociationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.config = config
+}
+
+func (r *SnowflakeTagAssociationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SnowflakeTagAssociationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating Snowflake tag association", map[string]interface{}{
+		"tag":         data.Tag.ValueString(),
+		"object_type": data.ObjectType.ValueString(),
+		"object_name": data.ObjectName.ValueString(),
+		"column":      data.Column.ValueString(),
+	})
+
+	tag, err := lookupTag(r.config, data.Tag.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Snowflake Tag Association",
+			fmt.Sprintf("Could not read tag %s: %s", data.Tag.ValueString(), err),
+		)
+		return
+	}
+	if tag != nil {
+		resp.Diagnostics.Append(validateTagValue(tag, data.Tag.ValueString(), data.Value.ValueString())...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	associationConfig := map[string]interface{}{
+		"tag":        data.Tag.ValueString(),
+		"value":      data.Value.ValueString(),
+		"objectType": data.ObjectType.ValueString(),
+		"objectName": data.ObjectName.ValueString(),
+		"column":     data.Column.ValueString(),
+	}
+
+	var result map[string]interface{}
+	err = r.config.OVHClient.Post("/cloud/project/snowflake/tag-association", associationConfig, &result)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Snowflake Tag Association",
+			fmt.Sprintf("Could not set tag %s on %s %s: %s",
+				data.Tag.ValueString(), data.ObjectType.ValueString(), data.ObjectName.ValueString(), err),
+		)
+		return
+	}
+
+	data.ID = apiString(result, "id")
+
+	resp.Diagnostics.Append(r.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Created Snowflake tag association")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(tagAssociationIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
+}
+
+func (r *SnowflakeTagAssociationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SnowflakeTagAssociationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Snowflake tag association", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	var association map[string]interface{}
+	err := r.config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/tag-association/%s", data.ID.ValueString()), &association)
+	if isNotFoundError(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Snowflake Tag Association",
+			fmt.Sprintf("Could not read tag association %s: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	data.refresh(association)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(tagAssociationIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
+}
+
+func (r *SnowflakeTagAssociationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SnowflakeTagAssociationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating Snowflake tag association", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	updateConfig := map[string]interface{}{
+		"value": data.Value.ValueString(),
+	}
+
+	err := r.config.OVHClient.Put(fmt.Sprintf("/cloud/project/snowflake/tag-association/%s", data.ID.ValueString()), updateConfig, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Snowflake Tag Association",
+			fmt.Sprintf("Could not update tag association %s: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(tagAssociationIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
+}
+
+func (r *SnowflakeTagAssociationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SnowflakeTagAssociationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting Snowflake tag association", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.config.OVHClient.Delete(fmt.Sprintf("/cloud/project/snowflake/tag-association/%s", data.ID.ValueString()), nil)
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Snowflake Tag Association",
+			fmt.Sprintf("Could not unset tag %s on %s %s: %s",
+				data.Tag.ValueString(), data.ObjectType.ValueString(), data.ObjectName.ValueString(), err),
+		)
+	}
+}
+
+// ImportState accepts IDs of the form tag|object_type|object_name, or
+// tag|object_type|object_name|column for a column.
+func (r *SnowflakeTagAssociationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = tagAssociationIdentity.importID(ctx, r.config, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if importByID(ctx, r.config, "tag association", "/cloud/project/snowflake/tag-association", req.ID, resp) {
+		return
+	}
+
+	parts, err := splitImportID(req.ID, "tag|object_type|object_name or tag|object_type|object_name|column", 3, 4)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	filters := map[string]string{
+		"tag":        parts[0],
+		"objectType": strings.ToUpper(parts[1]),
+		"objectName": parts[2],
+		"column":     "",
+	}
+	if len(parts) == 4 {
+		filters["column"] = parts[3]
+	}
+
+	importByLookup(ctx, r.config, "tag association", "/cloud/project/snowflake/tag-association", filters, resp)
+}
+
+// read refreshes data from the OVH API after a create or update.
+func (r *SnowflakeTagAssociationResource) read(ctx context.Context, data *SnowflakeTagAssociationResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var association map[string]interface{}
+	err := r.config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/tag-association/%s", data.ID.ValueString()), &association)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Snowflake Tag Association",
+			fmt.Sprintf("Could not read tag association %s: %s", data.ID.ValueString(), err),
+		)
+		return diags
+	}
+
+	data.refresh(association)
+	return diags
+}
+
+// refresh copies an OVH API tag association into the model.
+func (m *SnowflakeTagAssociationResourceModel) refresh(association map[string]interface{}) {
+	m.Tag = apiString(association, "tag")
+	m.Value = apiString(association, "value")
+	m.ObjectType = apiString(association, "objectType")
+	m.ObjectName = apiOptionalString(association, "objectName")
+	m.Column = apiOptionalString(association, "column")
+}
@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestTagValueAllowed(t *testing.T) {
+	allowed := []types.String{types.StringValue("finance"), types.StringValue("hr")}
+
+	testCases := map[string]struct {
+		allowedValues []types.String
+		value         string
+		expected      bool
+	}{
+		"no_restriction": {allowedValues: nil, value: "anything", expected: true},
+		"allowed":        {allowedValues: allowed, value: "hr", expected: true},
+		"not_allowed":    {allowedValues: allowed, value: "sales", expected: false},
+		"case_sensitive": {allowedValues: allowed, value: "HR", expected: false},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := tagValueAllowed(tc.allowedValues, tc.value); got != tc.expected {
+				t.Errorf("expected %t, got %t", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestValidateTagValue(t *testing.T) {
+	tag := map[string]interface{}{"allowedValues": []interface{}{"finance", "hr"}}
+
+	if diags := validateTagValue(tag, "DB.SCH.DEPARTMENT", "hr"); diags.HasError() {
+		t.Errorf("unexpected diagnostics: %v", diags)
+	}
+	if diags := validateTagValue(tag, "DB.SCH.DEPARTMENT", "sales"); !diags.HasError() {
+		t.Error("expected an error for a value outside allowed_values")
+	}
+}
+
+func TestSnowflakeTagAssociationResource_ModifyPlan(t *testing.T) {
+	const (
+		lookup = "GET /cloud/project/snowflake/tag?database=GOVERNANCE&schema=TAGS&name=DEPARTMENT"
+		read   = "GET /cloud/project/snowflake/tag/7"
+	)
+
+	testCases := map[string]struct {
+		routes  map[string]interface{}
+		value   string
+		wantErr bool
+	}{
+		"allowed": {
+			routes:  map[string]interface{}{lookup: []string{"7"}, read: map[string]interface{}{"allowedValues": []string{"finance", "hr"}}},
+			value:   "hr",
+			wantErr: false,
+		},
+		"not_allowed": {
+			routes:  map[string]interface{}{lookup: []string{"7"}, read: map[string]interface{}{"allowedValues": []string{"finance", "hr"}}},
+			value:   "sales",
+			wantErr: true,
+		},
+		// The tag may be created in the same apply.
+		"not_found": {
+			routes: map[string]interface{}{lookup: []string{}},
+			value:  "sales",
+		},
+		"lookup_failure": {
+			routes:  map[string]interface{}{lookup: fakeOVHError(http.StatusForbidden)},
+			value:   "hr",
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			_, config := newFakeOVHAPI(t, tc.routes)
+			r := &SnowflakeTagAssociationResource{config: config}
+
+			schemaResp := &fwresource.SchemaResponse{}
+			r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+			plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+			diags := plan.Set(ctx, &SnowflakeTagAssociationResourceModel{
+				ID:         types.StringUnknown(),
+				Tag:        types.StringValue("GOVERNANCE.TAGS.DEPARTMENT"),
+				Value:      types.StringValue(tc.value),
+				ObjectType: types.StringValue("WAREHOUSE"),
+				ObjectName: types.StringValue("ANALYTICS_WH"),
+				Column:     types.StringNull(),
+			})
+			if diags.HasError() {
+				t.Fatalf("setting plan: %v", diags)
+			}
+
+			resp := &fwresource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, fwresource.ModifyPlanRequest{Plan: plan}, resp)
+			if resp.Diagnostics.HasError() != tc.wantErr {
+				t.Errorf("expected error %t, got %v", tc.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
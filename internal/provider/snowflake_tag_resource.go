@@ -0,0 +1,449 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/swcstudio/terraform-provider-ovh.snowflake/internal/sdk/identifiers"
+)
+
+var (
+	_ resource.Resource                   = &SnowflakeTagResource{}
+	_ resource.ResourceWithValidateConfig = &SnowflakeTagResource{}
+	_ resource.ResourceWithImportState    = &SnowflakeTagResource{}
+	_ resource.ResourceWithIdentity       = &SnowflakeTagResource{}
+)
+
+var tagIdentity = schemaObjectIdentity("tag")
+
+func NewSnowflakeTagResource() resource.Resource {
+	return &SnowflakeTagResource{}
+}
+
+type SnowflakeTagResource struct {
+	config *Config
+}
+
+type SnowflakeTagResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	Database           types.String `tfsdk:"database"`
+	Schema             types.String `tfsdk:"schema"`
+	AllowedValues      types.List   `tfsdk:"allowed_values"`
+	MaskingPolicies    types.List   `tfsdk:"masking_policies"`
+	Comment            types.String `tfsdk:"comment"`
+	FullyQualifiedName types.String `tfsdk:"fully_qualified_name"`
+	Owner              types.String `tfsdk:"owner"`
+	CreatedOn          types.String `tfsdk:"created_on"`
+}
+
+// maxTagAllowedValues is the number of allowed values Snowflake accepts on a
+// single tag.
+const maxTagAllowedValues = 300
+
+func (r *SnowflakeTagResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tag"
+}
+
+func (r *SnowflakeTagResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Snowflake object tag on OVH infrastructure. Assign it to objects with snowflake-ovh_tag_association.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Unique identifier for the tag.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the tag.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					identifiers.ValidName(),
+				},
+			},
+			"database": schema.StringAttribute{
+				Description: "Database that contains the tag.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					identifiers.ValidName(),
+				},
+			},
+			"schema": schema.StringAttribute{
+				Description: "Schema that contains the tag.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					identifiers.ValidName(),
+				},
+			},
+			"allowed_values": schema.ListAttribute{
+				Description: "Values the tag may be set to. When omitted, any value is allowed.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"masking_policies": schema.ListAttribute{
+				Description: "Fully qualified names of masking policies that protect columns carrying this tag, at most one per data type.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"comment": schema.StringAttribute{
+				Description: "Comment for the tag.",
+				Optional:    true,
+			},
+			"fully_qualified_name": schema.StringAttribute{
+				Description: "Fully qualified name of the tag.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"owner": schema.StringAttribute{
+				Description: "Role that owns the tag.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_on": schema.StringAttribute{
+				Description: "Creation timestamp of the tag.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SnowflakeTagResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = tagIdentity.schema()
+}
+
+func (r *SnowflakeTagResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data SnowflakeTagResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.AllowedValues.IsNull() || data.AllowedValues.IsUnknown() {
+		return
+	}
+
+	var allowedValues []types.String
+	resp.Diagnostics.Append(data.AllowedValues.ElementsAs(ctx, &allowedValues, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(allowedValues) > maxTagAllowedValues {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("allowed_values"),
+			"Too Many Allowed Values",
+			fmt.Sprintf("A tag can have at most %d allowed values, got %d.", maxTagAllowedValues, len(allowedValues)),
+		)
+	}
+
+	seen := map[string]bool{}
+	for _, value := range allowedValues {
+		if value.IsUnknown() || value.IsNull() {
+			continue
+		}
+		if seen[value.ValueString()] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("allowed_values"),
+				"Duplicate Allowed Value",
+				fmt.Sprintf("Allowed value %q is listed more than once.", value.ValueString()),
+			)
+		}
+		seen[value.ValueString()] = true
+	}
+}
+
+func (r *SnowflakeTagResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.config = config
+}
+
+func (r *SnowflakeTagResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SnowflakeTagResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating Snowflake tag", map[string]interface{}{
+		"name":     data.Name.ValueString(),
+		"database": data.Database.ValueString(),
+		"schema":   data.Schema.ValueString(),
+	})
+
+	allowedValues, maskingPolicies, diags := data.lists(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tagConfig := map[string]interface{}{
+		"name":            data.Name.ValueString(),
+		"database":        data.Database.ValueString(),
+		"schema":          data.Schema.ValueString(),
+		"allowedValues":   allowedValues,
+		"maskingPolicies": maskingPolicies,
+		"comment":         data.Comment.ValueString(),
+	}
+
+	var result map[string]interface{}
+	err := r.config.OVHClient.Post("/cloud/project/snowflake/tag", tagConfig, &result)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Snowflake Tag",
+			fmt.Sprintf("Could not create tag %s: %s", data.Name.ValueString(), err),
+		)
+		return
+	}
+
+	data.ID = apiString(result, "id")
+
+	resp.Diagnostics.Append(r.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Created Snowflake tag")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(tagIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
+}
+
+func (r *SnowflakeTagResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SnowflakeTagResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Snowflake tag", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	var tag map[string]interface{}
+	err := r.config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/tag/%s", data.ID.ValueString()), &tag)
+	if isNotFoundError(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Snowflake Tag",
+			fmt.Sprintf("Could not read tag %s: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(data.refresh(ctx, tag)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(tagIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
+}
+
+func (r *SnowflakeTagResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state SnowflakeTagResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating Snowflake tag", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	allowedValues, maskingPolicies, diags := data.lists(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The API replaces the full lists, adding and dropping allowed values and
+	// masking policy bindings as needed.
+	updateConfig := map[string]interface{}{}
+	if !data.AllowedValues.Equal(state.AllowedValues) {
+		updateConfig["allowedValues"] = allowedValues
+	}
+	if !data.MaskingPolicies.Equal(state.MaskingPolicies) {
+		updateConfig["maskingPolicies"] = maskingPolicies
+	}
+	if !data.Comment.Equal(state.Comment) {
+		updateConfig["comment"] = data.Comment.ValueString()
+	}
+
+	if len(updateConfig) > 0 {
+		err := r.config.OVHClient.Put(fmt.Sprintf("/cloud/project/snowflake/tag/%s", data.ID.ValueString()), updateConfig, nil)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Snowflake Tag",
+				fmt.Sprintf("Could not update tag %s: %s", data.ID.ValueString(), err),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(tagIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
+}
+
+func (r *SnowflakeTagResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SnowflakeTagResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting Snowflake tag", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.config.OVHClient.Delete(fmt.Sprintf("/cloud/project/snowflake/tag/%s", data.ID.ValueString()), nil)
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Snowflake Tag",
+			fmt.Sprintf("Could not delete tag %s: %s", data.ID.ValueString(), err),
+		)
+	}
+}
+
+func (r *SnowflakeTagResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = tagIdentity.importID(ctx, r.config, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	importSchemaObject(ctx, r.config, "tag", "/cloud/project/snowflake/tag", req, resp)
+}
+
+// read refreshes data from the OVH API after a create or update.
+func (r *SnowflakeTagResource) read(ctx context.Context, data *SnowflakeTagResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var tag map[string]interface{}
+	err := r.config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/tag/%s", data.ID.ValueString()), &tag)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Snowflake Tag",
+			fmt.Sprintf("Could not read tag %s: %s", data.ID.ValueString(), err),
+		)
+		return diags
+	}
+
+	return data.refresh(ctx, tag)
+}
+
+// lists returns the allowed values and masking policies for the OVH API.
+func (m *SnowflakeTagResourceModel) lists(ctx context.Context) ([]string, []string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var allowedValues, maskingPolicies []types.String
+
+	if !m.AllowedValues.IsNull() {
+		diags.Append(m.AllowedValues.ElementsAs(ctx, &allowedValues, false)...)
+	}
+	if !m.MaskingPolicies.IsNull() {
+		diags.Append(m.MaskingPolicies.ElementsAs(ctx, &maskingPolicies, false)...)
+	}
+	return stringValues(allowedValues), stringValues(maskingPolicies), diags
+}
+
+// refresh copies an OVH API tag into the model. Empty lists are kept null so
+// that omitting allowed_values or masking_policies does not show a diff.
+func (m *SnowflakeTagResourceModel) refresh(ctx context.Context, tag map[string]interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.Name = apiString(tag, "name")
+	m.Database = apiString(tag, "database")
+	m.Schema = apiString(tag, "schema")
+	m.Comment = apiOptionalString(tag, "comment")
+	m.Owner = apiString(tag, "owner")
+	m.CreatedOn = apiString(tag, "createdOn")
+	m.FullyQualifiedName = types.StringValue(identifiers.NewSchemaObjectIdentifier(
+		m.Database.ValueString(), m.Schema.ValueString(), m.Name.ValueString()).FullyQualifiedName())
+
+	if allowedValues := apiStringList(tag, "allowedValues"); len(allowedValues) > 0 || !m.AllowedValues.IsNull() {
+		list, d := types.ListValueFrom(ctx, types.StringType, allowedValues)
+		diags.Append(d...)
+		m.AllowedValues = list
+	}
+	if maskingPolicies := apiStringList(tag, "maskingPolicies"); len(maskingPolicies) > 0 || !m.MaskingPolicies.IsNull() {
+		list, d := types.ListValueFrom(ctx, types.StringType, maskingPolicies)
+		diags.Append(d...)
+		m.MaskingPolicies = list
+	}
+
+	return diags
+}
+
+// lookupTag returns the tag with the given fully qualified name, or nil when
+// it does not exist.
+func lookupTag(config *Config, fullyQualifiedName string) (map[string]interface{}, error) {
+	id, err := identifiers.ParseSchemaObjectIdentifier(fullyQualifiedName)
+	if err != nil {
+		return nil, fmt.Errorf("tag name must be fully qualified as database.schema.name: %w", err)
+	}
+
+	var ids []string
+	err = config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/tag?database=%s&schema=%s&name=%s",
+		url.QueryEscape(id.DatabaseName()), url.QueryEscape(id.SchemaName()), url.QueryEscape(id.Name())), &ids)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	var tag map[string]interface{}
+	if err := config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/tag/%s", ids[0]), &tag); err != nil {
+		return nil, err
+	}
+	return tag, nil
+}
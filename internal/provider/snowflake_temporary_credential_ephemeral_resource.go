@@ -0,0 +1,312 @@
+package provider
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/swcstudio/terraform-provider-ovh.snowflake/internal/sdk/identifiers"
+)
+
+var (
+	_ ephemeral.EphemeralResource                   = &SnowflakeTemporaryCredentialEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure      = &SnowflakeTemporaryCredentialEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithValidateConfig = &SnowflakeTemporaryCredentialEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose          = &SnowflakeTemporaryCredentialEphemeralResource{}
+)
+
+func NewSnowflakeTemporaryCredentialEphemeralResource() ephemeral.EphemeralResource {
+	return &SnowflakeTemporaryCredentialEphemeralResource{}
+}
+
+type SnowflakeTemporaryCredentialEphemeralResource struct {
+	config *Config
+}
+
+type SnowflakeTemporaryCredentialEphemeralResourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	User                 types.String `tfsdk:"user"`
+	Role                 types.String `tfsdk:"role"`
+	Type                 types.String `tfsdk:"type"`
+	TTL                  types.String `tfsdk:"ttl"`
+	Comment              types.String `tfsdk:"comment"`
+	Token                types.String `tfsdk:"token"`
+	PrivateKey           types.String `tfsdk:"private_key"`
+	PublicKeyFingerprint types.String `tfsdk:"public_key_fingerprint"`
+	ExpiresAt            types.String `tfsdk:"expires_at"`
+}
+
+const (
+	temporaryCredentialToken   = "PROGRAMMATIC_ACCESS_TOKEN"
+	temporaryCredentialKeyPair = "KEY_PAIR"
+
+	// temporaryCredentialPrivateKey is the private state key holding the ID
+	// of the credential to revoke on Close.
+	temporaryCredentialPrivateKey = "credential_id"
+
+	defaultTemporaryCredentialTTL = time.Hour
+	minTemporaryCredentialTTL     = 5 * time.Minute
+	maxTemporaryCredentialTTL     = 24 * time.Hour
+)
+
+func (e *SnowflakeTemporaryCredentialEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_temporary_credential"
+}
+
+func (e *SnowflakeTemporaryCredentialEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Issues a short-lived Snowflake credential for a user on OVH infrastructure, for tools such as dbt or Airflow. The credential is never stored in state and is revoked when Terraform is done with it.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the issued credential.",
+				Computed:    true,
+			},
+			"user": schema.StringAttribute{
+				Description: "User the credential authenticates as.",
+				Required:    true,
+				Validators: []validator.String{
+					identifiers.ValidName(),
+				},
+			},
+			"role": schema.StringAttribute{
+				Description: "Role the credential is restricted to. When omitted, the user's default role applies.",
+				Optional:    true,
+				Validators: []validator.String{
+					identifiers.ValidName(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "Kind of credential: PROGRAMMATIC_ACCESS_TOKEN (default) or KEY_PAIR. For KEY_PAIR, the key pair is generated by the provider and only the public key is sent to Snowflake.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					oneOfValidator{values: []string{temporaryCredentialToken, temporaryCredentialKeyPair}},
+				},
+			},
+			"ttl": schema.StringAttribute{
+				Description: fmt.Sprintf("Lifetime of the credential as a Go duration, between %s and %s. Defaults to %s.", minTemporaryCredentialTTL, maxTemporaryCredentialTTL, defaultTemporaryCredentialTTL),
+				Optional:    true,
+			},
+			"comment": schema.StringAttribute{
+				Description: "Comment recorded with the credential in Snowflake.",
+				Optional:    true,
+			},
+			"token": schema.StringAttribute{
+				Description: "Programmatic access token, for PROGRAMMATIC_ACCESS_TOKEN credentials.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"private_key": schema.StringAttribute{
+				Description: "PEM encoded PKCS#8 private key, for KEY_PAIR credentials.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"public_key_fingerprint": schema.StringAttribute{
+				Description: "SHA-256 fingerprint of the public key registered for KEY_PAIR credentials.",
+				Computed:    true,
+			},
+			"expires_at": schema.StringAttribute{
+				Description: "Time at which Snowflake stops accepting the credential, in RFC 3339 format.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (e *SnowflakeTemporaryCredentialEphemeralResource) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	var data SnowflakeTemporaryCredentialEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.TTL.IsNull() || data.TTL.IsUnknown() {
+		return
+	}
+
+	if _, err := temporaryCredentialTTL(data.TTL); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("ttl"), "Invalid TTL", err.Error())
+	}
+}
+
+// temporaryCredentialTTL parses the configured lifetime, applying the
+// default when it is not set.
+func temporaryCredentialTTL(ttl types.String) (time.Duration, error) {
+	if ttl.IsNull() {
+		return defaultTemporaryCredentialTTL, nil
+	}
+
+	d, err := time.ParseDuration(ttl.ValueString())
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a valid duration: %w", ttl.ValueString(), err)
+	}
+	if d < minTemporaryCredentialTTL || d > maxTemporaryCredentialTTL {
+		return 0, fmt.Errorf("ttl must be between %s and %s, got %s", minTemporaryCredentialTTL, maxTemporaryCredentialTTL, d)
+	}
+	return d, nil
+}
+
+func (e *SnowflakeTemporaryCredentialEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	e.config = config
+}
+
+func (e *SnowflakeTemporaryCredentialEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data SnowflakeTemporaryCredentialEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ttl, err := temporaryCredentialTTL(data.TTL)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("ttl"), "Invalid TTL", err.Error())
+		return
+	}
+	if data.Type.IsNull() {
+		data.Type = types.StringValue(temporaryCredentialToken)
+	}
+
+	tflog.Debug(ctx, "Issuing Snowflake temporary credential", map[string]interface{}{
+		"user": data.User.ValueString(),
+		"role": data.Role.ValueString(),
+		"type": data.Type.ValueString(),
+		"ttl":  ttl.String(),
+	})
+
+	credentialConfig := map[string]interface{}{
+		"user":       data.User.ValueString(),
+		"role":       data.Role.ValueString(),
+		"type":       data.Type.ValueString(),
+		"ttlSeconds": int64(ttl.Seconds()),
+		"comment":    data.Comment.ValueString(),
+	}
+
+	data.Token = types.StringNull()
+	data.PrivateKey = types.StringNull()
+	data.PublicKeyFingerprint = types.StringNull()
+
+	if data.Type.ValueString() == temporaryCredentialKeyPair {
+		privateKey, publicKey, err := generateTemporaryKeyPair()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Generating Key Pair",
+				fmt.Sprintf("Could not generate a key pair for user %s: %s", data.User.ValueString(), err),
+			)
+			return
+		}
+		fingerprint, err := rsaPublicKeyFingerprint(publicKey)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Generating Key Pair",
+				fmt.Sprintf("Could not fingerprint the generated public key: %s", err),
+			)
+			return
+		}
+
+		credentialConfig["publicKey"] = normalizeRSAPublicKey(publicKey)
+		data.PrivateKey = types.StringValue(privateKey)
+		data.PublicKeyFingerprint = types.StringValue(fingerprint)
+	}
+
+	var result map[string]interface{}
+	err = e.config.OVHClient.Post("/cloud/project/snowflake/credential", credentialConfig, &result)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Issuing Snowflake Temporary Credential",
+			fmt.Sprintf("Could not issue a %s for user %s: %s", data.Type.ValueString(), data.User.ValueString(), err),
+		)
+		return
+	}
+
+	data.ID = apiString(result, "id")
+	data.ExpiresAt = apiString(result, "expiresAt")
+	if data.Type.ValueString() == temporaryCredentialToken {
+		data.Token = apiString(result, "token")
+	}
+
+	credentialID, err := json.Marshal(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Issuing Snowflake Temporary Credential",
+			fmt.Sprintf("Could not record credential %s for revocation: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, temporaryCredentialPrivateKey, credentialID)...)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (e *SnowflakeTemporaryCredentialEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	raw, diags := req.Private.GetKey(ctx, temporaryCredentialPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || raw == nil {
+		return
+	}
+
+	var credentialID string
+	if err := json.Unmarshal(raw, &credentialID); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Revoking Snowflake Temporary Credential",
+			fmt.Sprintf("Could not decode the credential ID: %s", err),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Revoking Snowflake temporary credential", map[string]interface{}{
+		"id": credentialID,
+	})
+
+	err := e.config.OVHClient.Delete(fmt.Sprintf("/cloud/project/snowflake/credential/%s", credentialID), nil)
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			"Error Revoking Snowflake Temporary Credential",
+			fmt.Sprintf("Could not revoke credential %s; it stays valid until it expires: %s", credentialID, err),
+		)
+	}
+}
+
+// generateTemporaryKeyPair returns a new 2048-bit RSA key pair as a PKCS#8
+// private key PEM and a public key PEM.
+func generateTemporaryKeyPair() (string, string, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return "", "", err
+	}
+
+	privateDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return "", "", err
+	}
+	publicDER, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		return "", "", err
+	}
+
+	privatePEM := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateDER})
+	publicPEM := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDER})
+	return string(privatePEM), string(publicPEM), nil
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTemporaryCredentialTTL(t *testing.T) {
+	tests := []struct {
+		ttl     types.String
+		want    time.Duration
+		wantErr bool
+	}{
+		{types.StringNull(), time.Hour, false},
+		{types.StringValue("15m"), 15 * time.Minute, false},
+		{types.StringValue("24h"), 24 * time.Hour, false},
+		{types.StringValue("1m"), 0, true},
+		{types.StringValue("48h"), 0, true},
+		{types.StringValue("one hour"), 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ttl.String(), func(t *testing.T) {
+			got, err := temporaryCredentialTTL(tt.ttl)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("temporaryCredentialTTL(%s) error = %v, wantErr %v", tt.ttl, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("temporaryCredentialTTL(%s) = %s, want %s", tt.ttl, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGenerateTemporaryKeyPair(t *testing.T) {
+	privateKey, publicKey, err := generateTemporaryKeyPair()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if privateKey == "" {
+		t.Error("expected a private key")
+	}
+	if _, err := rsaPublicKeyFingerprint(publicKey); err != nil {
+		t.Errorf("generated public key is not accepted: %s", err)
+	}
+}
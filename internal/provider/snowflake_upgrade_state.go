@@ -0,0 +1,24 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// upgradeStubID returns id, or null when it is the placeholder that version 0
+// of a resource stored before the resource was backed by the OVH API, such as
+// warehouse-ANALYTICS_WH. format and names rebuild that placeholder. Read then
+// looks the object up by name, as it does for state moved from the upstream
+// Snowflake provider.
+func upgradeStubID(id types.String, format string, names ...types.String) types.String {
+	args := make([]interface{}, len(names))
+	for i, name := range names {
+		args[i] = name.ValueString()
+	}
+
+	if id.ValueString() == fmt.Sprintf(format, args...) {
+		return types.StringNull()
+	}
+	return id
+}
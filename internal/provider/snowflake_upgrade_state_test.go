@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// upgradeState runs the state upgrader of r for version on raw state, as
+// Terraform does when it finds state written with an older schema version.
+func upgradeState(t *testing.T, r resource.Resource, version int64, rawState string) tfsdk.State {
+	t.Helper()
+	ctx := context.Background()
+
+	upgrader, ok := r.(resource.ResourceWithUpgradeState).UpgradeState(ctx)[version]
+	if !ok {
+		t.Fatalf("no state upgrader for version %d", version)
+	}
+
+	prior, err := (&tfprotov6.RawState{JSON: []byte(rawState)}).UnmarshalWithOpts(
+		upgrader.PriorSchema.Type().TerraformType(ctx),
+		tfprotov6.UnmarshalOpts{ValueFromJSONOpts: tftypes.ValueFromJSONOpts{IgnoreUndefinedAttributes: true}},
+	)
+	if err != nil {
+		t.Fatalf("state does not match the prior schema: %s", err)
+	}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	resp := &resource.UpgradeStateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	upgrader.StateUpgrader(ctx, resource.UpgradeStateRequest{
+		RawState: &tfprotov6.RawState{JSON: []byte(rawState)},
+		State:    &tfsdk.State{Schema: *upgrader.PriorSchema, Raw: prior},
+	}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("upgrade failed: %v", resp.Diagnostics)
+	}
+	return resp.State
+}
+
+func TestSnowflakeWarehouseResource_UpgradeStateV0(t *testing.T) {
+	state := upgradeState(t, NewSnowflakeWarehouseResource(), 0, `{
+		"id": "warehouse-ANALYTICS_WH", "name": "ANALYTICS_WH", "size": "XSMALL",
+		"auto_suspend": 300, "auto_resume": true, "initially_suspended": null, "comment": "reporting"
+	}`)
+
+	var got SnowflakeWarehouseResourceModel
+	if diags := state.Get(context.Background(), &got); diags.HasError() {
+		t.Fatalf("upgraded state does not match the model: %v", diags)
+	}
+	want := SnowflakeWarehouseResourceModel{
+		ID:          types.StringNull(),
+		Name:        types.StringValue("ANALYTICS_WH"),
+		Size:        types.StringValue("XSMALL"),
+		AutoSuspend: types.Int64Value(300),
+		AutoResume:  types.BoolValue(true),
+		Comment:     types.StringValue("reporting"),
+	}
+	if got != want {
+		t.Errorf("upgraded state = %+v, want %+v", got, want)
+	}
+}
+
+func TestUpgradeStateV0_PlaceholderIDs(t *testing.T) {
+	tests := []struct {
+		name     string
+		resource resource.Resource
+		state    string
+		wantID   types.String
+	}{
+		{
+			name:     "database",
+			resource: NewSnowflakeDatabaseResource(),
+			state:    `{"id": "database-ANALYTICS", "name": "ANALYTICS", "comment": null}`,
+			wantID:   types.StringNull(),
+		},
+		{
+			name:     "role",
+			resource: NewSnowflakeRoleResource(),
+			state:    `{"id": "role-ANALYST", "name": "ANALYST", "comment": "read only"}`,
+			wantID:   types.StringNull(),
+		},
+		{
+			name:     "schema",
+			resource: NewSnowflakeSchemaResource(),
+			state:    `{"id": "schema-ANALYTICS-PUBLIC", "name": "PUBLIC", "database": "ANALYTICS", "comment": null}`,
+			wantID:   types.StringNull(),
+		},
+		{
+			name:     "table",
+			resource: NewSnowflakeTableResource(),
+			state:    `{"id": "table-ANALYTICS-PUBLIC-EVENTS", "name": "EVENTS", "database": "ANALYTICS", "schema": "PUBLIC", "comment": null}`,
+			wantID:   types.StringNull(),
+		},
+		{
+			name:     "resource monitor",
+			resource: NewSnowflakeResourceMonitorResource(),
+			state: `{"id": "resource-monitor-MONTHLY", "name": "MONTHLY", "credit_quota": 100, "frequency": "MONTHLY",
+				"start_time": "IMMEDIATELY", "end_time": null, "suspend_at": 90, "suspend_immediately_at": null}`,
+			wantID: types.StringNull(),
+		},
+		{
+			name:     "user",
+			resource: NewSnowflakeUserResource(),
+			state:    `{"id": "user-JSMITH", "name": "JSMITH", "email": "jsmith@example.com", "password": null, "comment": null}`,
+			wantID:   types.StringNull(),
+		},
+		{
+			name:     "grant",
+			resource: NewSnowflakeGrantResource(),
+			state: `{"id": "grant-SELECT-TABLE-ANALYTICS.PUBLIC.EVENTS-ANALYST", "privilege": "SELECT",
+				"object_type": "TABLE", "object_name": "ANALYTICS.PUBLIC.EVENTS", "role": "ANALYST"}`,
+			wantID: types.StringNull(),
+		},
+		{
+			// Version 0 state written once the resources were backed by the
+			// OVH API already holds the OVH ID.
+			name:     "OVH ID",
+			resource: NewSnowflakeDatabaseResource(),
+			state:    `{"id": "8f2c1e0a", "name": "ANALYTICS", "comment": null}`,
+			wantID:   types.StringValue("8f2c1e0a"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := upgradeState(t, tt.resource, 0, tt.state)
+
+			var id types.String
+			state.GetAttribute(context.Background(), path.Root("id"), &id)
+			if !id.Equal(tt.wantID) {
+				t.Errorf("id = %s, want %s", id, tt.wantID)
+			}
+		})
+	}
+}
@@ -2,15 +2,39 @@ package provider
 
 import (
 	"context"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
 	"fmt"
+	"regexp"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/swcstudio/terraform-provider-ovh.snowflake/internal/sdk/identifiers"
+)
+
+var (
+	_ resource.Resource                   = &SnowflakeUserResource{}
+	_ resource.ResourceWithValidateConfig = &SnowflakeUserResource{}
+	_ resource.ResourceWithImportState    = &SnowflakeUserResource{}
+	_ resource.ResourceWithIdentity       = &SnowflakeUserResource{}
+	_ resource.ResourceWithMoveState      = &SnowflakeUserResource{}
+	_ resource.ResourceWithUpgradeState   = &SnowflakeUserResource{}
 )
 
-var _ resource.Resource = &SnowflakeUserResource{}
+var userIdentity = accountObjectIdentity("user")
 
 func NewSnowflakeUserResource() resource.Resource {
 	return &SnowflakeUserResource{}
@@ -21,11 +45,105 @@ type SnowflakeUserResource struct {
 }
 
 type SnowflakeUserResourceModel struct {
-	ID       types.String `tfsdk:"id"`
-	Name     types.String `tfsdk:"name"`
-	Email    types.String `tfsdk:"email"`
-	Password types.String `tfsdk:"password"`
-	Comment  types.String `tfsdk:"comment"`
+	ID                       types.String `tfsdk:"id"`
+	Name                     types.String `tfsdk:"name"`
+	Type                     types.String `tfsdk:"type"`
+	Email                    types.String `tfsdk:"email"`
+	Password                 types.String `tfsdk:"password"`
+	PasswordWO               types.String `tfsdk:"password_wo"`
+	PasswordWOVersion        types.Int64  `tfsdk:"password_wo_version"`
+	RSAPublicKey             types.String `tfsdk:"rsa_public_key"`
+	RSAPublicKey2            types.String `tfsdk:"rsa_public_key_2"`
+	RSAPublicKeyFingerprint  types.String `tfsdk:"rsa_public_key_fingerprint"`
+	RSAPublicKey2Fingerprint types.String `tfsdk:"rsa_public_key_2_fingerprint"`
+	Comment                  types.String `tfsdk:"comment"`
+}
+
+// userTypes are the Snowflake user types. PERSON users are humans;
+// SERVICE users are applications that authenticate with key pairs or OAuth
+// only; LEGACY_SERVICE users are applications still allowed a password.
+var userTypes = []string{"PERSON", "SERVICE", "LEGACY_SERVICE"}
+
+// serviceUserForbiddenAttributes lists attributes that describe a human and
+// cannot be set on SERVICE users.
+var serviceUserForbiddenAttributes = []string{"password", "first_name", "last_name", "must_change_password"}
+
+// checkUserType returns an error when an attribute in configured cannot be
+// used with userType.
+func checkUserType(userType string, configured []string) error {
+	if userType != "SERVICE" {
+		return nil
+	}
+
+	var forbidden []string
+	for _, attribute := range configured {
+		if stringInSlice(attribute, serviceUserForbiddenAttributes) {
+			forbidden = append(forbidden, attribute)
+		}
+	}
+	if len(forbidden) > 0 {
+		return fmt.Errorf("%s cannot be set on SERVICE users; authenticate them with rsa_public_key instead, or use type = \"LEGACY_SERVICE\"", strings.Join(forbidden, ", "))
+	}
+	return nil
+}
+
+var pemArmorPattern = regexp.MustCompile(`-----(BEGIN|END) [A-Z ]+-----`)
+
+// normalizeRSAPublicKey strips the PEM header, footer and whitespace from a
+// public key, leaving the base64 body Snowflake stores.
+func normalizeRSAPublicKey(key string) string {
+	key = pemArmorPattern.ReplaceAllString(key, "")
+	return strings.Join(strings.Fields(key), "")
+}
+
+// rsaPublicKeyFingerprint parses a PEM or bare base64 RSA public key and
+// returns its fingerprint in Snowflake's SHA256:<base64> format.
+func rsaPublicKeyFingerprint(key string) (string, error) {
+	der, err := base64.StdEncoding.DecodeString(normalizeRSAPublicKey(key))
+	if err != nil {
+		return "", fmt.Errorf("public key is not valid base64: %w", err)
+	}
+
+	parsed, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return "", fmt.Errorf("public key is not a valid PKIX public key: %w", err)
+	}
+	rsaKey, ok := parsed.(*rsa.PublicKey)
+	if !ok {
+		return "", fmt.Errorf("public key is a %T, not an RSA key", parsed)
+	}
+	if rsaKey.N.BitLen() < 2048 {
+		return "", fmt.Errorf("RSA public key must be at least 2048 bits, got %d", rsaKey.N.BitLen())
+	}
+
+	sum := sha256.Sum256(der)
+	return "SHA256:" + base64.StdEncoding.EncodeToString(sum[:]), nil
+}
+
+// rsaPublicKeyValidator checks that a string is an RSA public key Snowflake
+// accepts.
+type rsaPublicKeyValidator struct{}
+
+func (v rsaPublicKeyValidator) Description(ctx context.Context) string {
+	return "value must be a PEM encoded RSA public key of at least 2048 bits, with or without the header and footer"
+}
+
+func (v rsaPublicKeyValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v rsaPublicKeyValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := rsaPublicKeyFingerprint(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid RSA Public Key",
+			fmt.Sprintf("%s. Expected %s.", err, v.Description(ctx)),
+		)
+	}
 }
 
 func (r *SnowflakeUserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -33,26 +151,76 @@ func (r *SnowflakeUserResource) Metadata(ctx context.Context, req resource.Metad
 }
 
 func (r *SnowflakeUserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	rsaPublicKey := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			Description: description,
+			Optional:    true,
+			Validators: []validator.String{
+				rsaPublicKeyValidator{},
+			},
+		}
+	}
+	fingerprint := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			Description: description,
+			Computed:    true,
+		}
+	}
+
 	resp.Schema = schema.Schema{
+		Version:     1,
 		Description: "Manages a Snowflake user on OVH infrastructure.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "Unique identifier for the user.",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"name": schema.StringAttribute{
 				Description: "Name of the user.",
 				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					identifiers.ValidName(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "Type of the user: PERSON (default), SERVICE or LEGACY_SERVICE. SERVICE users cannot have a password.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("PERSON"),
+				Validators: []validator.String{
+					oneOfValidator{values: userTypes},
+				},
 			},
 			"email": schema.StringAttribute{
 				Description: "Email address of the user.",
 				Optional:    true,
 			},
 			"password": schema.StringAttribute{
-				Description: "Password for the user.",
+				Description:        "Password for the user. The value is stored in state; prefer password_wo.",
+				Optional:           true,
+				Sensitive:          true,
+				DeprecationMessage: "Use password_wo and password_wo_version to keep the password out of plan and state.",
+			},
+			"password_wo": schema.StringAttribute{
+				Description: "Write-only password for the user. It is never stored in plan or state; change password_wo_version to apply a new value. Requires Terraform 1.11 or later.",
 				Optional:    true,
 				Sensitive:   true,
+				WriteOnly:   true,
 			},
+			"password_wo_version": schema.Int64Attribute{
+				Description: "Version of password_wo, which must be set with it. The password is only sent to Snowflake on create and when this value changes.",
+				Optional:    true,
+			},
+			"rsa_public_key":               rsaPublicKey("RSA public key for key pair authentication, PEM encoded with or without the header and footer."),
+			"rsa_public_key_2":             rsaPublicKey("Second RSA public key. To rotate keys without downtime, add the new key here, move clients over, then remove the old key from rsa_public_key."),
+			"rsa_public_key_fingerprint":   fingerprint("SHA-256 fingerprint of rsa_public_key, as reported by Snowflake."),
+			"rsa_public_key_2_fingerprint": fingerprint("SHA-256 fingerprint of rsa_public_key_2, as reported by Snowflake."),
 			"comment": schema.StringAttribute{
 				Description: "Comment for the user.",
 				Optional:    true,
@@ -61,6 +229,57 @@ func (r *SnowflakeUserResource) Schema(ctx context.Context, req resource.SchemaR
 	}
 }
 
+func (r *SnowflakeUserResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = userIdentity.schema()
+}
+
+func (r *SnowflakeUserResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data SnowflakeUserResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Password.IsNull() && !data.PasswordWO.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("password_wo"),
+			"Conflicting Password Attributes",
+			"Only one of password and password_wo can be set.",
+		)
+	}
+	if !data.PasswordWO.IsNull() && data.PasswordWOVersion.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("password_wo_version"),
+			"Missing Password Version",
+			"password_wo_version is required with password_wo, so that password changes can be detected.",
+		)
+	}
+	if !data.PasswordWOVersion.IsNull() && data.PasswordWO.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("password_wo"),
+			"Missing Write-Only Password",
+			"password_wo is required with password_wo_version; changing the version alone would set an empty password.",
+		)
+	}
+
+	if data.Type.IsUnknown() {
+		return
+	}
+
+	var configured []string
+	if !data.Password.IsNull() || !data.PasswordWO.IsNull() {
+		configured = append(configured, "password")
+	}
+	if err := checkUserType(data.Type.ValueString(), configured); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("type"),
+			"Invalid Attribute For User Type",
+			err.Error()+".",
+		)
+	}
+}
+
 func (r *SnowflakeUserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -88,13 +307,46 @@ func (r *SnowflakeUserResource) Create(ctx context.Context, req resource.CreateR
 
 	tflog.Debug(ctx, "Creating Snowflake user", map[string]interface{}{
 		"name": data.Name.ValueString(),
+		"type": data.Type.ValueString(),
 	})
 
-	data.ID = types.StringValue(fmt.Sprintf("user-%s", data.Name.ValueString()))
+	password, diags := userPassword(ctx, req.Config, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userConfig := map[string]interface{}{
+		"name":          data.Name.ValueString(),
+		"type":          data.Type.ValueString(),
+		"email":         data.Email.ValueString(),
+		"password":      password,
+		"rsaPublicKey":  normalizeRSAPublicKey(data.RSAPublicKey.ValueString()),
+		"rsaPublicKey2": normalizeRSAPublicKey(data.RSAPublicKey2.ValueString()),
+		"comment":       data.Comment.ValueString(),
+	}
+
+	var result map[string]interface{}
+	err := r.config.OVHClient.Post("/cloud/project/snowflake/user", userConfig, &result)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Snowflake User",
+			fmt.Sprintf("Could not create user %s: %s", data.Name.ValueString(), err),
+		)
+		return
+	}
+
+	data.ID = apiString(result, "id")
+
+	resp.Diagnostics.Append(r.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	tflog.Trace(ctx, "Created Snowflake user")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(userIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
 }
 
 func (r *SnowflakeUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -109,13 +361,38 @@ func (r *SnowflakeUserResource) Read(ctx context.Context, req resource.ReadReque
 		"id": data.ID.ValueString(),
 	})
 
+	resp.Diagnostics.Append(resolveMissingID(r.config, "user", "/cloud/project/snowflake/user", map[string]string{
+		"name": data.Name.ValueString(),
+	}, &data.ID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var user map[string]interface{}
+	err := r.config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/user/%s", data.ID.ValueString()), &user)
+	if isNotFoundError(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Snowflake User",
+			fmt.Sprintf("Could not read user %s: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	data.refresh(user)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(userIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
 }
 
 func (r *SnowflakeUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data SnowflakeUserResourceModel
+	var data, state SnowflakeUserResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -124,7 +401,49 @@ func (r *SnowflakeUserResource) Update(ctx context.Context, req resource.UpdateR
 		"id": data.ID.ValueString(),
 	})
 
+	updateConfig := map[string]interface{}{}
+	if !data.Type.Equal(state.Type) {
+		updateConfig["type"] = data.Type.ValueString()
+	}
+	if !data.Email.Equal(state.Email) {
+		updateConfig["email"] = data.Email.ValueString()
+	}
+	if !data.Password.Equal(state.Password) || !data.PasswordWOVersion.Equal(state.PasswordWOVersion) {
+		password, diags := userPassword(ctx, req.Config, data)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		updateConfig["password"] = password
+	}
+	// Both keys are sent in one request so that a rotation never leaves the
+	// user without a valid key.
+	if !data.RSAPublicKey.Equal(state.RSAPublicKey) || !data.RSAPublicKey2.Equal(state.RSAPublicKey2) {
+		updateConfig["rsaPublicKey"] = normalizeRSAPublicKey(data.RSAPublicKey.ValueString())
+		updateConfig["rsaPublicKey2"] = normalizeRSAPublicKey(data.RSAPublicKey2.ValueString())
+	}
+	if !data.Comment.Equal(state.Comment) {
+		updateConfig["comment"] = data.Comment.ValueString()
+	}
+
+	if len(updateConfig) > 0 {
+		err := r.config.OVHClient.Put(fmt.Sprintf("/cloud/project/snowflake/user/%s", data.ID.ValueString()), updateConfig, nil)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Snowflake User",
+				fmt.Sprintf("Could not update user %s: %s", data.ID.ValueString(), err),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(userIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
 }
 
 func (r *SnowflakeUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -138,4 +457,153 @@ func (r *SnowflakeUserResource) Delete(ctx context.Context, req resource.DeleteR
 	tflog.Debug(ctx, "Deleting Snowflake user", map[string]interface{}{
 		"id": data.ID.ValueString(),
 	})
+
+	err := r.config.OVHClient.Delete(fmt.Sprintf("/cloud/project/snowflake/user/%s", data.ID.ValueString()), nil)
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Snowflake User",
+			fmt.Sprintf("Could not delete user %s: %s", data.ID.ValueString(), err),
+		)
+	}
+}
+
+func (r *SnowflakeUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = userIdentity.importID(ctx, r.config, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	importAccountObject(ctx, r.config, "user", "/cloud/project/snowflake/user", req, resp)
+}
+
+// upstreamUserTypes maps the upstream Snowflake provider's user resources to
+// the type of user they manage.
+var upstreamUserTypes = map[string]string{
+	"snowflake_user":                "PERSON",
+	"snowflake_service_user":        "SERVICE",
+	"snowflake_legacy_service_user": "LEGACY_SERVICE",
+}
+
+// MoveState moves users from the upstream Snowflake provider, which has one
+// resource per user type. Passwords are not moved: upstream state only holds
+// the configured password, which password_wo keeps out of state.
+func (r *SnowflakeUserResource) MoveState(ctx context.Context) []resource.StateMover {
+	sourceTypes := make([]string, 0, len(upstreamUserTypes))
+	for sourceType := range upstreamUserTypes {
+		sourceTypes = append(sourceTypes, sourceType)
+	}
+
+	return []resource.StateMover{
+		upstreamStateMover(sourceTypes, func(ctx context.Context, sourceType string, source upstreamState, resp *resource.MoveStateResponse) {
+			data := SnowflakeUserResourceModel{
+				Name:          source.string("name"),
+				Type:          types.StringValue(upstreamUserTypes[sourceType]),
+				Email:         source.string("email"),
+				RSAPublicKey:  source.string("rsa_public_key"),
+				RSAPublicKey2: source.string("rsa_public_key_2"),
+				Comment:       source.string("comment"),
+			}
+
+			resp.Diagnostics.Append(resp.TargetState.Set(ctx, &data)...)
+		}),
+	}
+}
+
+// snowflakeUserResourceModelV0 is the state of the user at version 0.
+type snowflakeUserResourceModelV0 struct {
+	ID       types.String `tfsdk:"id"`
+	Name     types.String `tfsdk:"name"`
+	Email    types.String `tfsdk:"email"`
+	Password types.String `tfsdk:"password"`
+	Comment  types.String `tfsdk:"comment"`
+}
+
+// UpgradeState upgrades state from version 0, which stored a placeholder
+// rather than the OVH ID of the user.
+func (r *SnowflakeUserResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":       schema.StringAttribute{Computed: true},
+					"name":     schema.StringAttribute{Required: true},
+					"email":    schema.StringAttribute{Optional: true},
+					"password": schema.StringAttribute{Optional: true, Sensitive: true},
+					"comment":  schema.StringAttribute{Optional: true},
+				},
+			},
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var prior snowflakeUserResourceModelV0
+				resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				data := SnowflakeUserResourceModel{
+					ID:       upgradeStubID(prior.ID, "user-%s", prior.Name),
+					Name:     prior.Name,
+					Type:     types.StringValue("PERSON"),
+					Email:    prior.Email,
+					Password: prior.Password,
+					Comment:  prior.Comment,
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			},
+		},
+	}
+}
+
+// read refreshes data from the OVH API after a create or update.
+func (r *SnowflakeUserResource) read(ctx context.Context, data *SnowflakeUserResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var user map[string]interface{}
+	err := r.config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/user/%s", data.ID.ValueString()), &user)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Snowflake User",
+			fmt.Sprintf("Could not read user %s: %s", data.ID.ValueString(), err),
+		)
+		return diags
+	}
+
+	data.refresh(user)
+	return diags
+}
+
+// userPassword returns the password to send to the API. The write-only
+// password is only available from the configuration.
+func userPassword(ctx context.Context, config tfsdk.Config, data SnowflakeUserResourceModel) (string, diag.Diagnostics) {
+	if !data.Password.IsNull() {
+		return data.Password.ValueString(), nil
+	}
+
+	var password types.String
+	diags := config.GetAttribute(ctx, path.Root("password_wo"), &password)
+	return password.ValueString(), diags
+}
+
+// refresh copies an OVH API user into the model. The password is never
+// returned, and public keys keep their configured PEM formatting when they
+// match what Snowflake stores.
+func (m *SnowflakeUserResourceModel) refresh(user map[string]interface{}) {
+	m.Name = apiString(user, "name")
+	m.Type = apiString(user, "type")
+	m.Email = apiOptionalString(user, "email")
+	m.Comment = apiOptionalString(user, "comment")
+	m.RSAPublicKey = refreshRSAPublicKey(m.RSAPublicKey, apiOptionalString(user, "rsaPublicKey"))
+	m.RSAPublicKey2 = refreshRSAPublicKey(m.RSAPublicKey2, apiOptionalString(user, "rsaPublicKey2"))
+	m.RSAPublicKeyFingerprint = apiOptionalString(user, "rsaPublicKeyFp")
+	m.RSAPublicKey2Fingerprint = apiOptionalString(user, "rsaPublicKey2Fp")
+}
+
+// refreshRSAPublicKey returns the key reported by the API, keeping the
+// configured value when both normalize to the same key.
+func refreshRSAPublicKey(configured, reported types.String) types.String {
+	if !configured.IsNull() && !configured.IsUnknown() && !reported.IsNull() &&
+		normalizeRSAPublicKey(configured.ValueString()) == reported.ValueString() {
+		return configured
+	}
+	return reported
 }
@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestRSAPublicKeyFingerprint(t *testing.T) {
+	generate := func(bits int) ([]byte, string) {
+		key, err := rsa.GenerateKey(rand.Reader, bits)
+		if err != nil {
+			t.Fatalf("generating key: %s", err)
+		}
+		der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+		if err != nil {
+			t.Fatalf("marshalling key: %s", err)
+		}
+		return der, string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+	}
+
+	der, armored := generate(2048)
+	sum := sha256.Sum256(der)
+	expected := "SHA256:" + base64.StdEncoding.EncodeToString(sum[:])
+
+	for name, key := range map[string]string{
+		"pem":  armored,
+		"bare": base64.StdEncoding.EncodeToString(der),
+	} {
+		t.Run(name, func(t *testing.T) {
+			got, err := rsaPublicKeyFingerprint(key)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got != expected {
+				t.Errorf("expected fingerprint %s, got %s", expected, got)
+			}
+		})
+	}
+
+	if normalizeRSAPublicKey(armored) != base64.StdEncoding.EncodeToString(der) {
+		t.Errorf("normalizing a PEM key should leave the base64 body")
+	}
+
+	_, small := generate(1024)
+	if _, err := rsaPublicKeyFingerprint(small); err == nil {
+		t.Errorf("expected a 1024-bit key to be rejected")
+	}
+	if _, err := rsaPublicKeyFingerprint("not a key"); err == nil {
+		t.Errorf("expected garbage to be rejected")
+	}
+}
+
+func TestCheckUserType(t *testing.T) {
+	tests := []struct {
+		userType   string
+		configured []string
+		wantErr    bool
+	}{
+		{"PERSON", []string{"password", "first_name"}, false},
+		{"LEGACY_SERVICE", []string{"password"}, false},
+		{"SERVICE", []string{"email"}, false},
+		{"SERVICE", []string{"password"}, true},
+		{"SERVICE", []string{"must_change_password"}, true},
+	}
+
+	for _, tt := range tests {
+		err := checkUserType(tt.userType, tt.configured)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("checkUserType(%s, %v) error = %v, wantErr %v", tt.userType, tt.configured, err, tt.wantErr)
+		}
+	}
+}
+
+func TestSnowflakeUserResource_ValidateConfigPassword(t *testing.T) {
+	testCases := map[string]struct {
+		password, passwordWO types.String
+		version              types.Int64
+		wantErr              bool
+	}{
+		"write_only":              {types.StringNull(), types.StringValue("s3cret!"), types.Int64Value(1), false},
+		"write_only_no_version":   {types.StringNull(), types.StringValue("s3cret!"), types.Int64Null(), true},
+		"version_only":            {types.StringNull(), types.StringNull(), types.Int64Value(2), true},
+		"password":                {types.StringValue("s3cret!"), types.StringNull(), types.Int64Null(), false},
+		"password_and_write_only": {types.StringValue("s3cret!"), types.StringValue("s3cret!"), types.Int64Value(1), true},
+		"none":                    {types.StringNull(), types.StringNull(), types.Int64Null(), false},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			r := &SnowflakeUserResource{}
+			schemaResp := &fwresource.SchemaResponse{}
+			r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+			config := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+			diags := config.Set(ctx, &SnowflakeUserResourceModel{
+				Name:              types.StringValue("JSMITH"),
+				Type:              types.StringValue("PERSON"),
+				Password:          tc.password,
+				PasswordWO:        tc.passwordWO,
+				PasswordWOVersion: tc.version,
+			})
+			if diags.HasError() {
+				t.Fatalf("setting config: %v", diags)
+			}
+
+			resp := &fwresource.ValidateConfigResponse{}
+			r.ValidateConfig(ctx, fwresource.ValidateConfigRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config.Raw}}, resp)
+			if resp.Diagnostics.HasError() != tc.wantErr {
+				t.Errorf("expected error %t, got %v", tc.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
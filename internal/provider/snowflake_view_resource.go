@@ -0,0 +1,531 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/swcstudio/terraform-provider-ovh.snowflake/internal/sdk/identifiers"
+)
+
+var (
+	_ resource.Resource                = &SnowflakeViewResource{}
+	_ resource.ResourceWithModifyPlan  = &SnowflakeViewResource{}
+	_ resource.ResourceWithImportState = &SnowflakeViewResource{}
+	_ resource.ResourceWithIdentity    = &SnowflakeViewResource{}
+)
+
+var viewIdentity = schemaObjectIdentity("view")
+
+func NewSnowflakeViewResource() resource.Resource {
+	return &SnowflakeViewResource{}
+}
+
+type SnowflakeViewResource struct {
+	config *Config
+}
+
+type SnowflakeViewResourceModel struct {
+	ID                 types.String      `tfsdk:"id"`
+	Name               types.String      `tfsdk:"name"`
+	Database           types.String      `tfsdk:"database"`
+	Schema             types.String      `tfsdk:"schema"`
+	Statement          SQLStatementValue `tfsdk:"statement"`
+	Secure             types.Bool        `tfsdk:"secure"`
+	Recursive          types.Bool        `tfsdk:"recursive"`
+	ChangeTracking     types.Bool        `tfsdk:"change_tracking"`
+	CopyGrants         types.Bool        `tfsdk:"copy_grants"`
+	Columns            types.List        `tfsdk:"columns"`
+	Comment            types.String      `tfsdk:"comment"`
+	FullyQualifiedName types.String      `tfsdk:"fully_qualified_name"`
+	ReferencedObjects  types.List        `tfsdk:"referenced_objects"`
+	Owner              types.String      `tfsdk:"owner"`
+	CreatedOn          types.String      `tfsdk:"created_on"`
+}
+
+// SnowflakeViewColumnModel describes a column comment on a view or
+// materialized view.
+type SnowflakeViewColumnModel struct {
+	Name    types.String `tfsdk:"name"`
+	Comment types.String `tfsdk:"comment"`
+}
+
+var viewColumnAttrTypes = map[string]attr.Type{
+	"name":    types.StringType,
+	"comment": types.StringType,
+}
+
+// viewColumnsAttribute is the columns schema shared by views and
+// materialized views. Unconfigured columns are planned by planViewColumns.
+func viewColumnsAttribute(objectType string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Description: fmt.Sprintf("Columns of the %s, in query order. Use this to set column comments; when omitted the columns are read from Snowflake and their comments are removed.", objectType),
+		Optional:    true,
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					Description: "Name of the column.",
+					Required:    true,
+					Validators: []validator.String{
+						identifiers.ValidName(),
+					},
+				},
+				"comment": schema.StringAttribute{
+					Description: "Comment for the column.",
+					Optional:    true,
+				},
+			},
+		},
+	}
+}
+
+// expandViewColumns converts the columns attribute into the OVH API payload.
+func expandViewColumns(ctx context.Context, columns types.List) ([]map[string]interface{}, diag.Diagnostics) {
+	result := []map[string]interface{}{}
+	if columns.IsNull() || columns.IsUnknown() {
+		return result, nil
+	}
+
+	var models []SnowflakeViewColumnModel
+	diags := columns.ElementsAs(ctx, &models, false)
+	for _, column := range models {
+		result = append(result, map[string]interface{}{
+			"name":    column.Name.ValueString(),
+			"comment": column.Comment.ValueString(),
+		})
+	}
+	return result, diags
+}
+
+// planViewColumns returns the planned columns of a view or materialized view
+// whose configuration leaves them out. The columns are still those of the
+// prior state, minus the comments that are no longer configured, unless a
+// new statement may change them.
+func planViewColumns(ctx context.Context, configured, state types.List, statementChanged bool) (types.List, diag.Diagnostics) {
+	if !configured.IsNull() || state.IsNull() || state.IsUnknown() {
+		return configured, nil
+	}
+	if statementChanged {
+		return types.ListUnknown(types.ObjectType{AttrTypes: viewColumnAttrTypes}), nil
+	}
+
+	var models []SnowflakeViewColumnModel
+	diags := state.ElementsAs(ctx, &models, false)
+	for i := range models {
+		models[i].Comment = types.StringNull()
+	}
+	columns, d := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: viewColumnAttrTypes}, models)
+	diags.Append(d...)
+	return columns, diags
+}
+
+// flattenViewColumns converts OVH API columns into the columns attribute.
+func flattenViewColumns(ctx context.Context, apiColumns interface{}) (types.List, diag.Diagnostics) {
+	raw, _ := apiColumns.([]interface{})
+	models := make([]SnowflakeViewColumnModel, 0, len(raw))
+	for _, item := range raw {
+		column, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		models = append(models, SnowflakeViewColumnModel{
+			Name:    apiString(column, "name"),
+			Comment: apiOptionalString(column, "comment"),
+		})
+	}
+	return types.ListValueFrom(ctx, types.ObjectType{AttrTypes: viewColumnAttrTypes}, models)
+}
+
+// referencedObjectsValue lists the objects read by a statement.
+func referencedObjectsValue(ctx context.Context, statement string) (types.List, diag.Diagnostics) {
+	references := sqlReferencedObjects(statement)
+	if references == nil {
+		references = []string{}
+	}
+	return types.ListValueFrom(ctx, types.StringType, references)
+}
+
+func (r *SnowflakeViewResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_view"
+}
+
+func (r *SnowflakeViewResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Snowflake view on OVH infrastructure.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Unique identifier for the view.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the view.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					identifiers.ValidName(),
+				},
+			},
+			"database": schema.StringAttribute{
+				Description: "Database that contains the view.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					identifiers.ValidName(),
+				},
+			},
+			"schema": schema.StringAttribute{
+				Description: "Schema that contains the view.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					identifiers.ValidName(),
+				},
+			},
+			"statement": schema.StringAttribute{
+				Description: "Query that defines the view. Differences in whitespace, comments, keyword case and trailing semicolons are ignored.",
+				Required:    true,
+				CustomType:  SQLStatementType{},
+			},
+			"secure": schema.BoolAttribute{
+				Description: "Whether the view is secure.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"recursive": schema.BoolAttribute{
+				Description: "Whether the view is recursive.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"change_tracking": schema.BoolAttribute{
+				Description: "Whether change tracking is enabled on the view.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"copy_grants": schema.BoolAttribute{
+				Description: "Whether to retain existing grants when the view definition is replaced.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"columns": viewColumnsAttribute("view"),
+			"comment": schema.StringAttribute{
+				Description: "Comment for the view.",
+				Optional:    true,
+			},
+			"fully_qualified_name": schema.StringAttribute{
+				Description: "Fully qualified name of the view.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"referenced_objects": schema.ListAttribute{
+				Description: "Tables and views the query reads from, as named in its FROM and JOIN clauses.",
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"owner": schema.StringAttribute{
+				Description: "Role that owns the view.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_on": schema.StringAttribute{
+				Description: "Creation timestamp of the view.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SnowflakeViewResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = viewIdentity.schema()
+}
+
+func (r *SnowflakeViewResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyViewPlan(ctx, req, resp)
+}
+
+func (r *SnowflakeViewResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.config = config
+}
+
+func (r *SnowflakeViewResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SnowflakeViewResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating Snowflake view", map[string]interface{}{
+		"name":     data.Name.ValueString(),
+		"database": data.Database.ValueString(),
+		"schema":   data.Schema.ValueString(),
+	})
+
+	columns, diags := expandViewColumns(ctx, data.Columns)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	viewConfig := map[string]interface{}{
+		"name":           data.Name.ValueString(),
+		"database":       data.Database.ValueString(),
+		"schema":         data.Schema.ValueString(),
+		"statement":      data.Statement.ValueString(),
+		"secure":         data.Secure.ValueBool(),
+		"recursive":      data.Recursive.ValueBool(),
+		"changeTracking": data.ChangeTracking.ValueBool(),
+		"columns":        columns,
+		"comment":        data.Comment.ValueString(),
+	}
+
+	var result map[string]interface{}
+	err := r.config.OVHClient.Post("/cloud/project/snowflake/view", viewConfig, &result)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Snowflake View",
+			fmt.Sprintf("Could not create view %s: %s", data.Name.ValueString(), err),
+		)
+		return
+	}
+
+	data.ID = apiString(result, "id")
+
+	resp.Diagnostics.Append(r.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Created Snowflake view")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(viewIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
+}
+
+func (r *SnowflakeViewResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SnowflakeViewResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Snowflake view", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	var view map[string]interface{}
+	err := r.config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/view/%s", data.ID.ValueString()), &view)
+	if isNotFoundError(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Snowflake View",
+			fmt.Sprintf("Could not read view %s: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(data.refresh(ctx, view)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(viewIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
+}
+
+func (r *SnowflakeViewResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state SnowflakeViewResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating Snowflake view", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	updateConfig := map[string]interface{}{}
+
+	// A new definition is applied with CREATE OR REPLACE, so only send it when
+	// the query itself changed rather than its formatting.
+	if !sqlEquivalent(data.Statement.ValueString(), state.Statement.ValueString()) || !data.Recursive.Equal(state.Recursive) {
+		updateConfig["statement"] = data.Statement.ValueString()
+		updateConfig["recursive"] = data.Recursive.ValueBool()
+		updateConfig["copyGrants"] = data.CopyGrants.ValueBool()
+	}
+	if !data.Secure.Equal(state.Secure) {
+		updateConfig["secure"] = data.Secure.ValueBool()
+	}
+	if !data.ChangeTracking.Equal(state.ChangeTracking) {
+		updateConfig["changeTracking"] = data.ChangeTracking.ValueBool()
+	}
+	if !data.Columns.Equal(state.Columns) {
+		columns, diags := expandViewColumns(ctx, data.Columns)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		updateConfig["columns"] = columns
+	}
+	if !data.Comment.Equal(state.Comment) {
+		updateConfig["comment"] = data.Comment.ValueString()
+	}
+
+	if len(updateConfig) > 0 {
+		err := r.config.OVHClient.Put(fmt.Sprintf("/cloud/project/snowflake/view/%s", data.ID.ValueString()), updateConfig, nil)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Snowflake View",
+				fmt.Sprintf("Could not update view %s: %s", data.ID.ValueString(), err),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(viewIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
+}
+
+func (r *SnowflakeViewResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SnowflakeViewResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting Snowflake view", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.config.OVHClient.Delete(fmt.Sprintf("/cloud/project/snowflake/view/%s", data.ID.ValueString()), nil)
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Snowflake View",
+			fmt.Sprintf("Could not delete view %s: %s", data.ID.ValueString(), err),
+		)
+	}
+}
+
+func (r *SnowflakeViewResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = viewIdentity.importID(ctx, r.config, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	importSchemaObject(ctx, r.config, "view", "/cloud/project/snowflake/view", req, resp)
+}
+
+// read refreshes data from the OVH API after a create or update.
+func (r *SnowflakeViewResource) read(ctx context.Context, data *SnowflakeViewResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var view map[string]interface{}
+	err := r.config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/view/%s", data.ID.ValueString()), &view)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Snowflake View",
+			fmt.Sprintf("Could not read view %s: %s", data.ID.ValueString(), err),
+		)
+		return diags
+	}
+
+	return data.refresh(ctx, view)
+}
+
+// refresh copies an OVH API view into the model. The statement is taken from
+// the view text; the framework keeps the configured statement when the two
+// are semantically equal.
+func (m *SnowflakeViewResourceModel) refresh(ctx context.Context, view map[string]interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.Name = apiString(view, "name")
+	m.Database = apiString(view, "database")
+	m.Schema = apiString(view, "schema")
+	m.Secure = apiBool(view, "secure")
+	m.Recursive = apiBool(view, "recursive")
+	m.ChangeTracking = apiBool(view, "changeTracking")
+	m.Comment = apiOptionalString(view, "comment")
+	m.Owner = apiString(view, "owner")
+	m.CreatedOn = apiString(view, "createdOn")
+	m.FullyQualifiedName = types.StringValue(identifiers.NewSchemaObjectIdentifier(
+		m.Database.ValueString(), m.Schema.ValueString(), m.Name.ValueString()).FullyQualifiedName())
+
+	// COPY GRANTS only applies when the view is replaced and is not reported
+	// back, so keep the configured value and fall back to the default.
+	if m.CopyGrants.IsNull() || m.CopyGrants.IsUnknown() {
+		m.CopyGrants = types.BoolValue(true)
+	}
+
+	if text, ok := view["text"].(string); ok && text != "" {
+		m.Statement = NewSQLStatementValue(viewQueryText(text))
+	}
+
+	columns, d := flattenViewColumns(ctx, view["columns"])
+	diags.Append(d...)
+	m.Columns = columns
+
+	references, d := referencedObjectsValue(ctx, m.Statement.ValueString())
+	diags.Append(d...)
+	m.ReferencedObjects = references
+
+	return diags
+}
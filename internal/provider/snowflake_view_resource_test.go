@@ -0,0 +1,221 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func testViewColumns(t *testing.T, columns ...SnowflakeViewColumnModel) types.List {
+	t.Helper()
+
+	list, diags := types.ListValueFrom(context.Background(), types.ObjectType{AttrTypes: viewColumnAttrTypes}, columns)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics building columns: %v", diags)
+	}
+	return list
+}
+
+func testViewModel(t *testing.T, statement string, columns types.List) SnowflakeViewResourceModel {
+	t.Helper()
+
+	references, diags := referencedObjectsValue(context.Background(), statement)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	return SnowflakeViewResourceModel{
+		ID:                 types.StringValue("1"),
+		Name:               types.StringValue("ACTIVE_USERS"),
+		Database:           types.StringValue("ANALYTICS"),
+		Schema:             types.StringValue("PUBLIC"),
+		Statement:          NewSQLStatementValue(statement),
+		Secure:             types.BoolValue(false),
+		Recursive:          types.BoolValue(false),
+		ChangeTracking:     types.BoolValue(false),
+		CopyGrants:         types.BoolValue(true),
+		Columns:            columns,
+		FullyQualifiedName: types.StringValue("ANALYTICS.PUBLIC.ACTIVE_USERS"),
+		ReferencedObjects:  references,
+		Owner:              types.StringValue("SYSADMIN"),
+		CreatedOn:          types.StringValue("2026-10-19T08:00:00Z"),
+	}
+}
+
+func TestPlanViewColumns(t *testing.T) {
+	ctx := context.Background()
+	null := types.ListNull(types.ObjectType{AttrTypes: viewColumnAttrTypes})
+	commented := testViewColumns(t,
+		SnowflakeViewColumnModel{Name: types.StringValue("ID"), Comment: types.StringValue("User ID")},
+		SnowflakeViewColumnModel{Name: types.StringValue("EMAIL"), Comment: types.StringNull()},
+	)
+	uncommented := testViewColumns(t,
+		SnowflakeViewColumnModel{Name: types.StringValue("ID"), Comment: types.StringNull()},
+		SnowflakeViewColumnModel{Name: types.StringValue("EMAIL"), Comment: types.StringNull()},
+	)
+
+	tests := []struct {
+		name             string
+		configured       types.List
+		state            types.List
+		statementChanged bool
+		want             types.List
+	}{
+		{"configured", uncommented, commented, false, uncommented},
+		{"removed", null, commented, false, uncommented},
+		{"removed with new statement", null, commented, true, types.ListUnknown(types.ObjectType{AttrTypes: viewColumnAttrTypes})},
+		{"never set", null, null, false, null},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, diags := planViewColumns(ctx, tt.configured, tt.state, tt.statementChanged)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestSnowflakeViewResource_ModifyPlanRemovesColumnComments(t *testing.T) {
+	ctx := context.Background()
+	r := &SnowflakeViewResource{}
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	null := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)
+
+	statement := "SELECT id, email FROM users"
+	state := testViewModel(t, statement, testViewColumns(t,
+		SnowflakeViewColumnModel{Name: types.StringValue("ID"), Comment: types.StringValue("User ID")},
+	))
+	// The framework proposes the prior columns when they are left out of the
+	// configuration.
+	config := testViewModel(t, statement, types.ListNull(types.ObjectType{AttrTypes: viewColumnAttrTypes}))
+
+	stateValue := tfsdk.State{Schema: schemaResp.Schema, Raw: null}
+	configValue := tfsdk.State{Schema: schemaResp.Schema, Raw: null}
+	if diags := stateValue.Set(ctx, &state); diags.HasError() {
+		t.Fatalf("setting state: %v", diags)
+	}
+	if diags := configValue.Set(ctx, &config); diags.HasError() {
+		t.Fatalf("setting config: %v", diags)
+	}
+
+	req := fwresource.ModifyPlanRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: configValue.Raw},
+		Plan:   tfsdk.Plan{Schema: schemaResp.Schema, Raw: stateValue.Raw},
+		State:  stateValue,
+	}
+	resp := &fwresource.ModifyPlanResponse{Plan: req.Plan}
+	r.ModifyPlan(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var plan SnowflakeViewResourceModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	want := testViewColumns(t, SnowflakeViewColumnModel{Name: types.StringValue("ID"), Comment: types.StringNull()})
+	if !plan.Columns.Equal(want) {
+		t.Errorf("expected the column comment to be removed, got %s", plan.Columns)
+	}
+}
+
+func TestSnowflakeViewResource_ModifyPlanReferencedObjects(t *testing.T) {
+	ctx := context.Background()
+	r := &SnowflakeViewResource{}
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	null := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)
+	columns := types.ListNull(types.ObjectType{AttrTypes: viewColumnAttrTypes})
+
+	state := testViewModel(t, "SELECT id FROM users", columns)
+	// UseStateForUnknown proposes the prior referenced_objects.
+	plan := testViewModel(t, "SELECT id FROM customers", columns)
+	plan.ReferencedObjects = state.ReferencedObjects
+
+	stateValue := tfsdk.State{Schema: schemaResp.Schema, Raw: null}
+	planValue := tfsdk.State{Schema: schemaResp.Schema, Raw: null}
+	if diags := stateValue.Set(ctx, &state); diags.HasError() {
+		t.Fatalf("setting state: %v", diags)
+	}
+	if diags := planValue.Set(ctx, &plan); diags.HasError() {
+		t.Fatalf("setting plan: %v", diags)
+	}
+
+	req := fwresource.ModifyPlanRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: planValue.Raw},
+		Plan:   tfsdk.Plan{Schema: schemaResp.Schema, Raw: planValue.Raw},
+		State:  stateValue,
+	}
+	resp := &fwresource.ModifyPlanResponse{Plan: req.Plan}
+	r.ModifyPlan(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var got SnowflakeViewResourceModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &got)...)
+	want, _ := referencedObjectsValue(ctx, "SELECT id FROM customers")
+	if !got.ReferencedObjects.Equal(want) {
+		t.Errorf("expected referenced_objects %s, got %s", want, got.ReferencedObjects)
+	}
+}
+
+func TestSnowflakeViewResource_UpdateSendsColumnComments(t *testing.T) {
+	const update = "PUT /cloud/project/snowflake/view/1"
+	ctx := context.Background()
+
+	api, config := newFakeOVHAPI(t, map[string]interface{}{
+		update: nil,
+		"GET /cloud/project/snowflake/view/1": map[string]interface{}{
+			"id": "1", "name": "ACTIVE_USERS", "database": "ANALYTICS", "schema": "PUBLIC",
+			"text":    "CREATE VIEW ACTIVE_USERS AS SELECT id, email FROM users",
+			"owner":   "SYSADMIN",
+			"columns": []interface{}{map[string]interface{}{"name": "ID"}},
+		},
+	})
+
+	statement := "SELECT id, email FROM users"
+	state := testViewModel(t, statement, testViewColumns(t,
+		SnowflakeViewColumnModel{Name: types.StringValue("ID"), Comment: types.StringValue("User ID")},
+	))
+	plan := testViewModel(t, statement, testViewColumns(t,
+		SnowflakeViewColumnModel{Name: types.StringValue("ID"), Comment: types.StringNull()},
+	))
+
+	r := &SnowflakeViewResource{config: config}
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	null := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)
+
+	req := fwresource.UpdateRequest{
+		Plan:  tfsdk.Plan{Schema: schemaResp.Schema, Raw: null},
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: null},
+	}
+	if diags := req.Plan.Set(ctx, &plan); diags.HasError() {
+		t.Fatalf("setting plan: %v", diags)
+	}
+	if diags := req.State.Set(ctx, &state); diags.HasError() {
+		t.Fatalf("setting state: %v", diags)
+	}
+	resp := &fwresource.UpdateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: null}}
+	r.Update(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("update failed: %v", resp.Diagnostics)
+	}
+
+	body := api.body(update)
+	if _, ok := body["statement"]; ok {
+		t.Errorf("expected the unchanged statement to be left out, got %v", body)
+	}
+	columns, _ := body["columns"].([]interface{})
+	if len(columns) != 1 || columns[0].(map[string]interface{})["comment"] != "" {
+		t.Errorf("expected the column comment to be cleared, got %v", body["columns"])
+	}
+}
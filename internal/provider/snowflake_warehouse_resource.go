@@ -3,14 +3,91 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/swcstudio/terraform-provider-ovh.snowflake/internal/sdk/identifiers"
+)
+
+var (
+	_ resource.Resource                   = &SnowflakeWarehouseResource{}
+	_ resource.ResourceWithValidateConfig = &SnowflakeWarehouseResource{}
+	_ resource.ResourceWithModifyPlan     = &SnowflakeWarehouseResource{}
+	_ resource.ResourceWithImportState    = &SnowflakeWarehouseResource{}
+	_ resource.ResourceWithIdentity       = &SnowflakeWarehouseResource{}
+	_ resource.ResourceWithMoveState      = &SnowflakeWarehouseResource{}
+	_ resource.ResourceWithUpgradeState   = &SnowflakeWarehouseResource{}
 )
 
-var _ resource.Resource = &SnowflakeWarehouseResource{}
+var warehouseIdentity = accountObjectIdentity("warehouse")
+
+// warehouseSizes lists the canonical Snowflake warehouse sizes, smallest first.
+var warehouseSizes = []string{
+	"X-SMALL",
+	"SMALL",
+	"MEDIUM",
+	"LARGE",
+	"X-LARGE",
+	"2X-LARGE",
+	"3X-LARGE",
+	"4X-LARGE",
+	"5X-LARGE",
+	"6X-LARGE",
+}
+
+// warehouseTypes lists the Snowflake warehouse types.
+var warehouseTypes = []string{"STANDARD", "SNOWPARK-OPTIMIZED"}
+
+// warehouseScalingPolicies lists the policies by which a multi-cluster
+// warehouse starts and shuts down clusters.
+var warehouseScalingPolicies = []string{"STANDARD", "ECONOMY"}
+
+// warehouseResourceConstraints lists the resource constraints Snowflake
+// accepts: the memory and CPU architecture of Snowpark-optimized warehouses,
+// and the generation of standard warehouses.
+var warehouseResourceConstraints = []string{
+	"MEMORY_1X",
+	"MEMORY_1X_x86",
+	"MEMORY_16X",
+	"MEMORY_16X_x86",
+	"MEMORY_64X",
+	"MEMORY_64X_x86",
+	"STANDARD_GEN_1",
+	"STANDARD_GEN_2",
+}
+
+// warehouseSizeAliases maps the spellings Snowflake accepts for a warehouse
+// size, with separators removed, to the canonical size.
+var warehouseSizeAliases = map[string]string{
+	"XSMALL":   "X-SMALL",
+	"SMALL":    "SMALL",
+	"MEDIUM":   "MEDIUM",
+	"LARGE":    "LARGE",
+	"XLARGE":   "X-LARGE",
+	"XXLARGE":  "2X-LARGE",
+	"X2LARGE":  "2X-LARGE",
+	"2XLARGE":  "2X-LARGE",
+	"XXXLARGE": "3X-LARGE",
+	"X3LARGE":  "3X-LARGE",
+	"3XLARGE":  "3X-LARGE",
+	"X4LARGE":  "4X-LARGE",
+	"4XLARGE":  "4X-LARGE",
+	"X5LARGE":  "5X-LARGE",
+	"5XLARGE":  "5X-LARGE",
+	"X6LARGE":  "6X-LARGE",
+	"6XLARGE":  "6X-LARGE",
+}
 
 func NewSnowflakeWarehouseResource() resource.Resource {
 	return &SnowflakeWarehouseResource{}
@@ -24,10 +101,21 @@ type SnowflakeWarehouseResourceModel struct {
 	ID                 types.String `tfsdk:"id"`
 	Name               types.String `tfsdk:"name"`
 	Size               types.String `tfsdk:"size"`
+	WarehouseType      types.String `tfsdk:"warehouse_type"`
+	ResourceConstraint types.String `tfsdk:"resource_constraint"`
+	MinClusterCount    types.Int64  `tfsdk:"min_cluster_count"`
+	MaxClusterCount    types.Int64  `tfsdk:"max_cluster_count"`
+	ScalingPolicy      types.String `tfsdk:"scaling_policy"`
 	AutoSuspend        types.Int64  `tfsdk:"auto_suspend"`
 	AutoResume         types.Bool   `tfsdk:"auto_resume"`
 	InitiallySuspended types.Bool   `tfsdk:"initially_suspended"`
+	Suspended          types.Bool   `tfsdk:"suspended"`
+	WaitForCompletion  types.Bool   `tfsdk:"wait_for_completion"`
 	Comment            types.String `tfsdk:"comment"`
+
+	EnableQueryAcceleration         types.Bool  `tfsdk:"enable_query_acceleration"`
+	QueryAccelerationMaxScaleFactor types.Int64 `tfsdk:"query_acceleration_max_scale_factor"`
+	MaxConcurrencyLevel             types.Int64 `tfsdk:"max_concurrency_level"`
 }
 
 func (r *SnowflakeWarehouseResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -37,34 +125,154 @@ func (r *SnowflakeWarehouseResource) Metadata(ctx context.Context, req resource.
 func (r *SnowflakeWarehouseResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages a Snowflake warehouse on OVH infrastructure.",
+		Version:     1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "Unique identifier for the warehouse.",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"name": schema.StringAttribute{
 				Description: "Name of the warehouse.",
 				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					identifiers.ValidName(),
+				},
 			},
 			"size": schema.StringAttribute{
 				Description: "Size of the warehouse (X-SMALL, SMALL, MEDIUM, LARGE, X-LARGE, etc.).",
 				Optional:    true,
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"warehouse_type": schema.StringAttribute{
+				Description: "Type of the warehouse: STANDARD or SNOWPARK-OPTIMIZED. Snowflake only changes the type of a suspended warehouse, so a running warehouse is suspended while it changes and resumed afterwards.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					oneOfValidator{values: warehouseTypes},
+				},
+			},
+			"resource_constraint": schema.StringAttribute{
+				Description: "Memory and CPU architecture of a Snowpark-optimized warehouse (MEMORY_1X, MEMORY_16X_x86, etc.), or generation of a standard warehouse (STANDARD_GEN_1, STANDARD_GEN_2).",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					oneOfValidator{values: warehouseResourceConstraints},
+				},
+			},
+			"min_cluster_count": schema.Int64Attribute{
+				Description: "Minimum number of clusters of a multi-cluster warehouse, between 1 and max_cluster_count.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64RangeValidator{min: 1, max: 10},
+				},
+			},
+			"max_cluster_count": schema.Int64Attribute{
+				Description: "Maximum number of clusters of a multi-cluster warehouse, between 1 and 10. Values above 1 require the Enterprise edition.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64RangeValidator{min: 1, max: 10},
+				},
+			},
+			"scaling_policy": schema.StringAttribute{
+				Description: "Policy by which a multi-cluster warehouse starts and shuts down clusters: STANDARD or ECONOMY. Only applies when max_cluster_count is above 1.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					oneOfValidator{values: warehouseScalingPolicies},
+				},
 			},
 			"auto_suspend": schema.Int64Attribute{
-				Description: "Number of seconds to wait before automatically suspending the warehouse.",
+				Description: "Number of seconds to wait before automatically suspending the warehouse: 0, which never suspends it, or at least 60.",
 				Optional:    true,
 				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					autoSuspendValidator{},
+				},
 			},
 			"auto_resume": schema.BoolAttribute{
 				Description: "Whether to automatically resume the warehouse when accessed.",
 				Optional:    true,
 				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"initially_suspended": schema.BoolAttribute{
 				Description: "Whether the warehouse should be created in a suspended state.",
 				Optional:    true,
 				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"suspended": schema.BoolAttribute{
+				Description: "Whether the warehouse should be suspended. Changing it suspends or resumes the warehouse. " +
+					"Snowflake still suspends and resumes the warehouse on its own as auto_suspend and auto_resume allow, which is not reported as drift.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				Description: "Whether a resize returns only once the new compute resources are provisioned.",
+				Optional:    true,
+			},
+			"enable_query_acceleration": schema.BoolAttribute{
+				Description: "Whether the query acceleration service is enabled.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"query_acceleration_max_scale_factor": schema.Int64Attribute{
+				Description: "Maximum size of the compute resources the query acceleration service leases, as a multiple of the warehouse size. 0 removes the limit.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64RangeValidator{min: 0, max: 100},
+				},
+			},
+			"max_concurrency_level": schema.Int64Attribute{
+				Description: "Number of SQL statements the warehouse runs concurrently before queuing them.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 			},
 			"comment": schema.StringAttribute{
 				Description: "Comment for the warehouse.",
@@ -74,6 +282,10 @@ func (r *SnowflakeWarehouseResource) Schema(ctx context.Context, req resource.Sc
 	}
 }
 
+func (r *SnowflakeWarehouseResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = warehouseIdentity.schema()
+}
+
 func (r *SnowflakeWarehouseResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -91,6 +303,34 @@ func (r *SnowflakeWarehouseResource) Configure(ctx context.Context, req resource
 	r.config = config
 }
 
+func (r *SnowflakeWarehouseResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data SnowflakeWarehouseResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.validate()...)
+}
+
+// ModifyPlan rejects Enterprise features when the provider knows the account
+// is on the Standard edition. Only configured values are checked, so that
+// settings Snowflake reports are never blamed on the configuration.
+func (r *SnowflakeWarehouseResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.config == nil || r.config.SnowflakeEdition == "" {
+		return
+	}
+
+	var data SnowflakeWarehouseResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.checkEdition(r.config.SnowflakeEdition)...)
+}
+
 func (r *SnowflakeWarehouseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data SnowflakeWarehouseResourceModel
 
@@ -103,11 +343,85 @@ func (r *SnowflakeWarehouseResource) Create(ctx context.Context, req resource.Cr
 		"name": data.Name.ValueString(),
 	})
 
-	data.ID = types.StringValue(fmt.Sprintf("warehouse-%s", data.Name.ValueString()))
+	// Settings left out of the configuration are not sent, so that Snowflake
+	// applies its own defaults and Read reports them.
+	warehouseConfig := map[string]interface{}{
+		"name":    data.Name.ValueString(),
+		"comment": data.Comment.ValueString(),
+	}
+	if !data.Size.IsUnknown() {
+		warehouseConfig["size"] = data.Size.ValueString()
+	}
+	if !data.WarehouseType.IsUnknown() {
+		warehouseConfig["type"] = data.WarehouseType.ValueString()
+	}
+	if !data.ResourceConstraint.IsUnknown() {
+		warehouseConfig["resourceConstraint"] = data.ResourceConstraint.ValueString()
+	}
+	if !data.MinClusterCount.IsUnknown() {
+		warehouseConfig["minClusterCount"] = data.MinClusterCount.ValueInt64()
+	}
+	if !data.MaxClusterCount.IsUnknown() {
+		warehouseConfig["maxClusterCount"] = data.MaxClusterCount.ValueInt64()
+	}
+	if !data.ScalingPolicy.IsUnknown() {
+		warehouseConfig["scalingPolicy"] = data.ScalingPolicy.ValueString()
+	}
+	if !data.AutoSuspend.IsUnknown() {
+		warehouseConfig["autoSuspend"] = data.AutoSuspend.ValueInt64()
+	}
+	if !data.AutoResume.IsUnknown() {
+		warehouseConfig["autoResume"] = data.AutoResume.ValueBool()
+	}
+	if !data.InitiallySuspended.IsUnknown() {
+		warehouseConfig["initiallySuspended"] = data.InitiallySuspended.ValueBool()
+	} else if !data.Suspended.IsUnknown() {
+		// A warehouse that should be suspended is created suspended.
+		warehouseConfig["initiallySuspended"] = data.Suspended.ValueBool()
+	}
+	if !data.EnableQueryAcceleration.IsUnknown() {
+		warehouseConfig["enableQueryAcceleration"] = data.EnableQueryAcceleration.ValueBool()
+	}
+	if !data.QueryAccelerationMaxScaleFactor.IsUnknown() {
+		warehouseConfig["queryAccelerationMaxScaleFactor"] = data.QueryAccelerationMaxScaleFactor.ValueInt64()
+	}
+	if !data.MaxConcurrencyLevel.IsUnknown() {
+		warehouseConfig["maxConcurrencyLevel"] = data.MaxConcurrencyLevel.ValueInt64()
+	}
+
+	var result map[string]interface{}
+	err := r.config.OVHClient.Post("/cloud/project/snowflake/warehouse", warehouseConfig, &result)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Snowflake Warehouse",
+			fmt.Sprintf("Could not create warehouse %s: %s", data.Name.ValueString(), err),
+		)
+		return
+	}
+
+	data.ID = apiString(result, "id")
+
+	// initially_suspended wins over suspended on creation; bring the
+	// warehouse to the state suspended asks for.
+	if !data.Suspended.IsUnknown() {
+		if err := setWarehouseSuspended(ctx, r.config, data.ID.ValueString(), data.Suspended.ValueBool()); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Creating Snowflake Warehouse",
+				fmt.Sprintf("Could not change the state of warehouse %s: %s", data.Name.ValueString(), err),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	tflog.Trace(ctx, "Created Snowflake warehouse")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(warehouseIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
 }
 
 func (r *SnowflakeWarehouseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -122,13 +436,38 @@ func (r *SnowflakeWarehouseResource) Read(ctx context.Context, req resource.Read
 		"id": data.ID.ValueString(),
 	})
 
+	resp.Diagnostics.Append(resolveMissingID(r.config, "warehouse", "/cloud/project/snowflake/warehouse", map[string]string{
+		"name": data.Name.ValueString(),
+	}, &data.ID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var warehouse map[string]interface{}
+	err := r.config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/warehouse/%s", data.ID.ValueString()), &warehouse)
+	if isNotFoundError(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Snowflake Warehouse",
+			fmt.Sprintf("Could not read warehouse %s: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	data.refresh(warehouse)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(warehouseIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
 }
 
 func (r *SnowflakeWarehouseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data SnowflakeWarehouseResourceModel
+	var data, state SnowflakeWarehouseResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -137,7 +476,97 @@ func (r *SnowflakeWarehouseResource) Update(ctx context.Context, req resource.Up
 		"id": data.ID.ValueString(),
 	})
 
+	changeSuspended := !data.Suspended.IsUnknown() && !data.Suspended.Equal(state.Suspended)
+	changeType := (!data.WarehouseType.IsUnknown() && !data.WarehouseType.Equal(state.WarehouseType)) ||
+		(!data.ResourceConstraint.IsUnknown() && !data.ResourceConstraint.Equal(state.ResourceConstraint))
+	suspendBefore, resumeAfter, err := warehouseSuspension(r.config, data.ID.ValueString(), changeSuspended, data.Suspended.ValueBool(), changeType)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Snowflake Warehouse",
+			fmt.Sprintf("Could not read the state of warehouse %s: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	if suspendBefore {
+		if err := setWarehouseSuspended(ctx, r.config, data.ID.ValueString(), true); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Snowflake Warehouse",
+				fmt.Sprintf("Could not suspend warehouse %s: %s", data.ID.ValueString(), err),
+			)
+			return
+		}
+	}
+
+	updateConfig := map[string]interface{}{}
+	if !data.Size.IsUnknown() && !data.Size.Equal(state.Size) {
+		updateConfig["size"] = data.Size.ValueString()
+		if data.WaitForCompletion.ValueBool() {
+			updateConfig["waitForCompletion"] = true
+		}
+	}
+	if !data.WarehouseType.IsUnknown() && !data.WarehouseType.Equal(state.WarehouseType) {
+		updateConfig["type"] = data.WarehouseType.ValueString()
+	}
+	if !data.ResourceConstraint.IsUnknown() && !data.ResourceConstraint.Equal(state.ResourceConstraint) {
+		updateConfig["resourceConstraint"] = data.ResourceConstraint.ValueString()
+	}
+	if !data.MinClusterCount.IsUnknown() && !data.MinClusterCount.Equal(state.MinClusterCount) {
+		updateConfig["minClusterCount"] = data.MinClusterCount.ValueInt64()
+	}
+	if !data.MaxClusterCount.IsUnknown() && !data.MaxClusterCount.Equal(state.MaxClusterCount) {
+		updateConfig["maxClusterCount"] = data.MaxClusterCount.ValueInt64()
+	}
+	if !data.ScalingPolicy.IsUnknown() && !data.ScalingPolicy.Equal(state.ScalingPolicy) {
+		updateConfig["scalingPolicy"] = data.ScalingPolicy.ValueString()
+	}
+	if !data.AutoSuspend.IsUnknown() && !data.AutoSuspend.Equal(state.AutoSuspend) {
+		updateConfig["autoSuspend"] = data.AutoSuspend.ValueInt64()
+	}
+	if !data.AutoResume.IsUnknown() && !data.AutoResume.Equal(state.AutoResume) {
+		updateConfig["autoResume"] = data.AutoResume.ValueBool()
+	}
+	if !data.EnableQueryAcceleration.IsUnknown() && !data.EnableQueryAcceleration.Equal(state.EnableQueryAcceleration) {
+		updateConfig["enableQueryAcceleration"] = data.EnableQueryAcceleration.ValueBool()
+	}
+	if !data.QueryAccelerationMaxScaleFactor.IsUnknown() && !data.QueryAccelerationMaxScaleFactor.Equal(state.QueryAccelerationMaxScaleFactor) {
+		updateConfig["queryAccelerationMaxScaleFactor"] = data.QueryAccelerationMaxScaleFactor.ValueInt64()
+	}
+	if !data.MaxConcurrencyLevel.IsUnknown() && !data.MaxConcurrencyLevel.Equal(state.MaxConcurrencyLevel) {
+		updateConfig["maxConcurrencyLevel"] = data.MaxConcurrencyLevel.ValueInt64()
+	}
+	if !data.Comment.Equal(state.Comment) {
+		updateConfig["comment"] = data.Comment.ValueString()
+	}
+
+	if len(updateConfig) > 0 {
+		err := r.config.OVHClient.Put(fmt.Sprintf("/cloud/project/snowflake/warehouse/%s", data.ID.ValueString()), updateConfig, nil)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Snowflake Warehouse",
+				fmt.Sprintf("Could not update warehouse %s: %s", data.ID.ValueString(), err),
+			)
+			return
+		}
+	}
+
+	if resumeAfter {
+		if err := setWarehouseSuspended(ctx, r.config, data.ID.ValueString(), false); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Snowflake Warehouse",
+				fmt.Sprintf("Could not resume warehouse %s: %s", data.ID.ValueString(), err),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(warehouseIdentity.set(ctx, r.config, resp.State, resp.Identity)...)
 }
 
 func (r *SnowflakeWarehouseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -151,4 +580,346 @@ func (r *SnowflakeWarehouseResource) Delete(ctx context.Context, req resource.De
 	tflog.Debug(ctx, "Deleting Snowflake warehouse", map[string]interface{}{
 		"id": data.ID.ValueString(),
 	})
+
+	err := r.config.OVHClient.Delete(fmt.Sprintf("/cloud/project/snowflake/warehouse/%s", data.ID.ValueString()), nil)
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Snowflake Warehouse",
+			fmt.Sprintf("Could not delete warehouse %s: %s", data.ID.ValueString(), err),
+		)
+	}
+}
+
+func (r *SnowflakeWarehouseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = warehouseIdentity.importID(ctx, r.config, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	importAccountObject(ctx, r.config, "warehouse", "/cloud/project/snowflake/warehouse", req, resp)
+}
+
+// MoveState moves warehouses from the upstream Snowflake provider. Older
+// upstream versions store auto_resume as a boolean and newer ones as a string.
+func (r *SnowflakeWarehouseResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		upstreamStateMover([]string{"snowflake_warehouse"}, func(ctx context.Context, sourceType string, source upstreamState, resp *resource.MoveStateResponse) {
+			data := SnowflakeWarehouseResourceModel{
+				Name:               source.string("name"),
+				Size:               source.string("warehouse_size"),
+				MinClusterCount:    source.int64("min_cluster_count"),
+				MaxClusterCount:    source.int64("max_cluster_count"),
+				ScalingPolicy:      source.string("scaling_policy"),
+				AutoSuspend:        source.int64("auto_suspend"),
+				AutoResume:         source.bool("auto_resume"),
+				InitiallySuspended: source.bool("initially_suspended"),
+				WarehouseType:      source.string("warehouse_type"),
+				ResourceConstraint: source.string("resource_constraint"),
+				Comment:            source.string("comment"),
+
+				EnableQueryAcceleration:         source.bool("enable_query_acceleration"),
+				QueryAccelerationMaxScaleFactor: source.int64("query_acceleration_max_scale_factor"),
+				MaxConcurrencyLevel:             source.int64("max_concurrency_level"),
+			}
+			if size, err := normalizeWarehouseSize(data.Size.ValueString()); err == nil {
+				data.Size = types.StringValue(size)
+			}
+
+			resp.Diagnostics.Append(resp.TargetState.Set(ctx, &data)...)
+		}),
+	}
+}
+
+// snowflakeWarehouseResourceModelV0 is the state of the warehouse at version 0.
+type snowflakeWarehouseResourceModelV0 struct {
+	ID                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	Size               types.String `tfsdk:"size"`
+	AutoSuspend        types.Int64  `tfsdk:"auto_suspend"`
+	AutoResume         types.Bool   `tfsdk:"auto_resume"`
+	InitiallySuspended types.Bool   `tfsdk:"initially_suspended"`
+	Comment            types.String `tfsdk:"comment"`
+}
+
+// UpgradeState upgrades state from version 0, which stored a placeholder
+// rather than the OVH ID of the warehouse and had no cluster counts. The read
+// that follows fills both in.
+func (r *SnowflakeWarehouseResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":                  schema.StringAttribute{Computed: true},
+					"name":                schema.StringAttribute{Required: true},
+					"size":                schema.StringAttribute{Optional: true, Computed: true},
+					"auto_suspend":        schema.Int64Attribute{Optional: true, Computed: true},
+					"auto_resume":         schema.BoolAttribute{Optional: true, Computed: true},
+					"initially_suspended": schema.BoolAttribute{Optional: true, Computed: true},
+					"comment":             schema.StringAttribute{Optional: true},
+				},
+			},
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var prior snowflakeWarehouseResourceModelV0
+				resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				data := SnowflakeWarehouseResourceModel{
+					ID:                 upgradeStubID(prior.ID, "warehouse-%s", prior.Name),
+					Name:               prior.Name,
+					Size:               prior.Size,
+					AutoSuspend:        prior.AutoSuspend,
+					AutoResume:         prior.AutoResume,
+					InitiallySuspended: prior.InitiallySuspended,
+					Comment:            prior.Comment,
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			},
+		},
+	}
+}
+
+// read refreshes data from the OVH API after a create or update.
+func (r *SnowflakeWarehouseResource) read(ctx context.Context, data *SnowflakeWarehouseResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var warehouse map[string]interface{}
+	err := r.config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/warehouse/%s", data.ID.ValueString()), &warehouse)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Snowflake Warehouse",
+			fmt.Sprintf("Could not read warehouse %s: %s", data.ID.ValueString(), err),
+		)
+		return diags
+	}
+
+	data.refresh(warehouse)
+	return diags
+}
+
+// refresh copies an OVH API warehouse into the model. The size keeps its
+// configured spelling when it names the size Snowflake reports, and
+// initially_suspended, which only applies on creation, keeps its value when
+// the API does not report it. suspended is the desired state and only follows
+// the reported state when it is not known yet, e.g. on import.
+func (m *SnowflakeWarehouseResourceModel) refresh(warehouse map[string]interface{}) {
+	m.Name = apiString(warehouse, "name")
+	m.Size = refreshWarehouseSize(m.Size, apiString(warehouse, "size"))
+	m.WarehouseType = apiString(warehouse, "type")
+	m.ResourceConstraint = apiOptionalString(warehouse, "resourceConstraint")
+	m.MinClusterCount = apiInt64(warehouse, "minClusterCount")
+	m.MaxClusterCount = apiInt64(warehouse, "maxClusterCount")
+	m.ScalingPolicy = apiOptionalString(warehouse, "scalingPolicy")
+	m.AutoSuspend = apiInt64(warehouse, "autoSuspend")
+	m.AutoResume = apiBool(warehouse, "autoResume")
+	if suspended := apiBool(warehouse, "initiallySuspended"); !suspended.IsNull() || m.InitiallySuspended.IsUnknown() {
+		m.InitiallySuspended = suspended
+	}
+	if m.Suspended.IsNull() || m.Suspended.IsUnknown() {
+		m.Suspended = warehouseSuspended(warehouse)
+	}
+	m.EnableQueryAcceleration = apiBool(warehouse, "enableQueryAcceleration")
+	m.QueryAccelerationMaxScaleFactor = apiInt64(warehouse, "queryAccelerationMaxScaleFactor")
+	m.MaxConcurrencyLevel = apiInt64(warehouse, "maxConcurrencyLevel")
+	m.Comment = apiOptionalString(warehouse, "comment")
+}
+
+// validate checks the settings of a multi-cluster warehouse against each
+// other. Settings that are unknown or left to Snowflake are not checked.
+func (m SnowflakeWarehouseResourceModel) validate() diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	minKnown := !m.MinClusterCount.IsNull() && !m.MinClusterCount.IsUnknown()
+	maxKnown := !m.MaxClusterCount.IsNull() && !m.MaxClusterCount.IsUnknown()
+
+	if minKnown && maxKnown {
+		if err := checkWarehouseClusterCounts(m.MinClusterCount.ValueInt64(), m.MaxClusterCount.ValueInt64()); err != nil {
+			diags.AddAttributeError(path.Root("min_cluster_count"), "Invalid Cluster Counts", err.Error())
+		}
+	}
+
+	if !m.ScalingPolicy.IsNull() && maxKnown {
+		if err := checkWarehouseScalingPolicy(m.MaxClusterCount.ValueInt64()); err != nil {
+			diags.AddAttributeError(path.Root("scaling_policy"), "Invalid Scaling Policy", err.Error())
+		}
+	}
+
+	return diags
+}
+
+// checkEdition rejects the configured settings that edition does not offer.
+func (m SnowflakeWarehouseResourceModel) checkEdition(edition string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	multiCluster := m.MinClusterCount.ValueInt64() > 1 || m.MaxClusterCount.ValueInt64() > 1 || !m.ScalingPolicy.IsNull()
+	if err := checkWarehouseEdition(edition, multiCluster, m.EnableQueryAcceleration.ValueBool()); err != nil {
+		diags.AddError("Warehouse Feature Not Available", err.Error())
+	}
+
+	return diags
+}
+
+// checkWarehouseClusterCounts returns an error when a warehouse could start
+// more clusters than it may run.
+func checkWarehouseClusterCounts(minClusterCount, maxClusterCount int64) error {
+	if minClusterCount > maxClusterCount {
+		return fmt.Errorf("min_cluster_count (%d) must not be greater than max_cluster_count (%d)", minClusterCount, maxClusterCount)
+	}
+	return nil
+}
+
+// checkWarehouseScalingPolicy returns an error when a scaling policy is set on
+// a warehouse that runs a single cluster, which it has no effect on.
+func checkWarehouseScalingPolicy(maxClusterCount int64) error {
+	if maxClusterCount <= 1 {
+		return fmt.Errorf("scaling_policy only applies to multi-cluster warehouses; set max_cluster_count above 1 or remove it")
+	}
+	return nil
+}
+
+// checkWarehouseAutoSuspend returns an error unless seconds is 0, which never
+// suspends the warehouse, or at least 60, the shortest delay Snowflake honours.
+func checkWarehouseAutoSuspend(seconds int64) error {
+	if seconds != 0 && seconds < 60 {
+		return fmt.Errorf("auto_suspend must be 0 or at least 60 seconds, got %d", seconds)
+	}
+	return nil
+}
+
+// checkWarehouseEdition returns an error listing the Enterprise features a
+// warehouse uses when the account is on a lower edition. multiCluster is set
+// when a cluster count above 1 or a scaling policy is configured.
+func checkWarehouseEdition(edition string, multiCluster, queryAcceleration bool) error {
+	if accountEditionRank(edition) >= accountEditionRank("ENTERPRISE") {
+		return nil
+	}
+
+	var features []string
+	if multiCluster {
+		features = append(features, "multi-cluster warehouses")
+	}
+	if queryAcceleration {
+		features = append(features, "the query acceleration service")
+	}
+	if len(features) == 0 {
+		return nil
+	}
+	return fmt.Errorf("the %s edition does not offer %s, which require the Enterprise edition or higher", edition, strings.Join(features, " or "))
+}
+
+// autoSuspendValidator checks that auto_suspend is 0 or at least 60.
+type autoSuspendValidator struct{}
+
+func (v autoSuspendValidator) Description(ctx context.Context) string {
+	return "value must be 0 or at least 60"
+}
+
+func (v autoSuspendValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v autoSuspendValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := checkWarehouseAutoSuspend(req.ConfigValue.ValueInt64()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Attribute Value", err.Error()+".")
+	}
+}
+
+// warehouseSuspended reports whether an OVH API warehouse is suspended, or
+// is being suspended.
+func warehouseSuspended(warehouse map[string]interface{}) types.Bool {
+	state := apiString(warehouse, "state")
+	if state.IsNull() {
+		return types.BoolNull()
+	}
+	return types.BoolValue(state.ValueString() == "SUSPENDED" || state.ValueString() == "SUSPENDING")
+}
+
+// setWarehouseSuspended suspends or resumes a warehouse. Snowflake rejects
+// suspending a suspended warehouse and resuming a started one, and
+// auto_suspend and auto_resume change the state on their own, so nothing is
+// done when the warehouse is in the wanted state already.
+func setWarehouseSuspended(ctx context.Context, config *Config, id string, suspended bool) error {
+	current, err := readWarehouseSuspended(config, id)
+	if err != nil {
+		return err
+	}
+	if !current.IsNull() && current.ValueBool() == suspended {
+		return nil
+	}
+
+	action := "resume"
+	if suspended {
+		action = "suspend"
+	}
+	tflog.Debug(ctx, "Changing Snowflake warehouse state", map[string]interface{}{
+		"id":     id,
+		"action": action,
+	})
+	return config.OVHClient.Post(fmt.Sprintf("/cloud/project/snowflake/warehouse/%s/%s", id, action), nil, nil)
+}
+
+// readWarehouseSuspended reports whether a warehouse is suspended now.
+func readWarehouseSuspended(config *Config, id string) (types.Bool, error) {
+	var warehouse map[string]interface{}
+	if err := config.OVHClient.Get(fmt.Sprintf("/cloud/project/snowflake/warehouse/%s", id), &warehouse); err != nil {
+		return types.BoolNull(), err
+	}
+	return warehouseSuspended(warehouse), nil
+}
+
+// warehouseSuspension decides whether an update suspends a warehouse before
+// changing its settings and resumes it afterwards. changeSuspended is set when
+// the update sets suspended to the given value, and changeType when it changes
+// the type or resource constraint, which Snowflake only changes on a suspended
+// warehouse. A running warehouse is then suspended around the change and
+// resumed afterwards, unless the update suspends it anyway.
+func warehouseSuspension(config *Config, id string, changeSuspended, suspended, changeType bool) (suspendBefore, resumeAfter bool, err error) {
+	suspendBefore = changeSuspended && suspended
+	resumeAfter = changeSuspended && !suspended
+	if !changeType || suspendBefore {
+		return suspendBefore, resumeAfter, nil
+	}
+
+	current, err := readWarehouseSuspended(config, id)
+	if err != nil {
+		return false, false, err
+	}
+	if !current.ValueBool() {
+		return true, true, nil
+	}
+	return false, resumeAfter, nil
+}
+
+// refreshWarehouseSize returns the size reported by the API, keeping the
+// configured value when both are spellings of the same size.
+func refreshWarehouseSize(configured, reported types.String) types.String {
+	if configured.IsNull() || configured.IsUnknown() || reported.IsNull() {
+		return reported
+	}
+	want, err := normalizeWarehouseSize(configured.ValueString())
+	if err != nil {
+		return reported
+	}
+	if got, err := normalizeWarehouseSize(reported.ValueString()); err == nil && got == want {
+		return configured
+	}
+	return reported
+}
+
+// normalizeWarehouseSize returns the canonical spelling of a warehouse size,
+// accepting any case and the XXLARGE, X2LARGE and 2X-LARGE style variants.
+func normalizeWarehouseSize(size string) (string, error) {
+	key := strings.ToUpper(size)
+	key = strings.NewReplacer("-", "", "_", "", " ", "").Replace(key)
+
+	if canonical, ok := warehouseSizeAliases[key]; ok {
+		return canonical, nil
+	}
+	return "", fmt.Errorf("unknown warehouse size %q, expected one of %s", size, strings.Join(warehouseSizes, ", "))
 }
@@ -1,12 +1,22 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"reflect"
 	"regexp"
+	"strings"
 	"testing"
 
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/swcstudio/terraform-provider-ovh.snowflake/internal/sdk/identifiers"
 )
 
 func TestAccSnowflakeOVHWarehouse_basic(t *testing.T) {
@@ -253,20 +263,20 @@ func TestSnowflakeOVHWarehouse_ValidateSize(t *testing.T) {
 }
 
 func TestSnowflakeOVHWarehouse_ValidateAutoSuspend(t *testing.T) {
-	validValues := []int{60, 300, 3600, 86400}
-	invalidValues := []int{-1, 0, 30, 90000}
+	validValues := []int64{0, 60, 300, 3600, 86400, 90000}
+	invalidValues := []int64{-1, 1, 30, 59}
 
 	for _, value := range validValues {
 		t.Run(fmt.Sprintf("valid_auto_suspend_%d", value), func(t *testing.T) {
-			if !isValidAutoSuspend(value) {
-				t.Errorf("Auto suspend value %d should be valid", value)
+			if err := checkWarehouseAutoSuspend(value); err != nil {
+				t.Errorf("Auto suspend value %d should be valid: %s", value, err)
 			}
 		})
 	}
 
 	for _, value := range invalidValues {
 		t.Run(fmt.Sprintf("invalid_auto_suspend_%d", value), func(t *testing.T) {
-			if isValidAutoSuspend(value) {
+			if checkWarehouseAutoSuspend(value) == nil {
 				t.Errorf("Auto suspend value %d should be invalid", value)
 			}
 		})
@@ -274,20 +284,30 @@ func TestSnowflakeOVHWarehouse_ValidateAutoSuspend(t *testing.T) {
 }
 
 func TestSnowflakeOVHWarehouse_ValidateName(t *testing.T) {
-	validNames := []string{"warehouse1", "test_warehouse", "PROD_WH", "analytics_wh_123"}
-	invalidNames := []string{"", "123warehouse", "warehouse-name", "warehouse name", "very_long_warehouse_name_that_exceeds_maximum_length_allowed_by_snowflake"}
+	validNames := map[string]string{
+		"PROD_WH":          "PROD_WH",
+		"ANALYTICS_WH_123": "ANALYTICS_WH_123",
+		"warehouse1":       `"warehouse1"`,
+		"123warehouse":     `"123warehouse"`,
+		"warehouse-name":   `"warehouse-name"`,
+		"warehouse name":   `"warehouse name"`,
+	}
+	invalidNames := []string{"", strings.Repeat("w", identifiers.MaxNameLength+1)}
 
-	for _, name := range validNames {
+	for name, want := range validNames {
 		t.Run(fmt.Sprintf("valid_name_%s", name), func(t *testing.T) {
-			if !isValidWarehouseName(name) {
-				t.Errorf("Warehouse name %s should be valid", name)
+			if err := identifiers.ValidateName(name); err != nil {
+				t.Errorf("Warehouse name %s should be valid: %s", name, err)
+			}
+			if got := identifiers.NewAccountObjectIdentifier(name).FullyQualifiedName(); got != want {
+				t.Errorf("Warehouse name %s should be written as %s, got %s", name, want, got)
 			}
 		})
 	}
 
 	for _, name := range invalidNames {
-		t.Run(fmt.Sprintf("invalid_name_%s", name), func(t *testing.T) {
-			if isValidWarehouseName(name) {
+		t.Run(fmt.Sprintf("invalid_name_%d_chars", len(name)), func(t *testing.T) {
+			if err := identifiers.ValidateName(name); err == nil {
 				t.Errorf("Warehouse name %s should be invalid", name)
 			}
 		})
@@ -366,30 +386,6 @@ func isValidWarehouseSize(size string) bool {
 	return validSizes[size]
 }
 
-func isValidAutoSuspend(value int) bool {
-	return value >= 60 && value <= 86400
-}
-
-func isValidWarehouseName(name string) bool {
-	if len(name) == 0 || len(name) > 64 {
-		return false
-	}
-
-	// Check if name starts with a letter or underscore
-	if !((name[0] >= 'a' && name[0] <= 'z') || (name[0] >= 'A' && name[0] <= 'Z') || name[0] == '_') {
-		return false
-	}
-
-	// Check that all characters are alphanumeric or underscore
-	for _, char := range name {
-		if !((char >= 'a' && char <= 'z') || (char >= 'A' && char <= 'Z') || (char >= '0' && char <= '9') || char == '_') {
-			return false
-		}
-	}
-
-	return true
-}
-
 // Test configuration templates
 func testAccSnowflakeOVHWarehouseConfig_basic(name string) string {
 	return fmt.Sprintf(`
@@ -498,3 +494,269 @@ resource "snowflake_ovh_warehouse" "test" {
 }
 `, name)
 }
+
+func TestSnowflakeWarehouseResourceModel_refresh(t *testing.T) {
+	warehouse := map[string]interface{}{
+		"name":        "ANALYTICS_WH",
+		"size":        "XSMALL",
+		"autoSuspend": json.Number("600"),
+		"autoResume":  true,
+		"comment":     "",
+	}
+
+	// On import the model starts out null, and every attribute comes from the
+	// API, with an empty comment left unset.
+	var imported SnowflakeWarehouseResourceModel
+	imported.refresh(warehouse)
+	if imported.Size.ValueString() != "XSMALL" || imported.AutoSuspend.ValueInt64() != 600 || !imported.AutoResume.ValueBool() {
+		t.Errorf("unexpected settings %s, %s, %s", imported.Size, imported.AutoSuspend, imported.AutoResume)
+	}
+	if !imported.Comment.IsNull() {
+		t.Errorf("comment = %s, want null", imported.Comment)
+	}
+	if !imported.InitiallySuspended.IsNull() {
+		t.Errorf("initially_suspended = %s, want null when the API does not report it", imported.InitiallySuspended)
+	}
+
+	// A configured size keeps its spelling when it names the same size.
+	configured := SnowflakeWarehouseResourceModel{
+		Size:               types.StringValue("x-small"),
+		InitiallySuspended: types.BoolValue(true),
+	}
+	configured.refresh(warehouse)
+	if got := configured.Size.ValueString(); got != "x-small" {
+		t.Errorf("size = %q, want the configured x-small", got)
+	}
+	if !configured.InitiallySuspended.ValueBool() {
+		t.Error("initially_suspended lost its configured value")
+	}
+
+	// A size changed outside Terraform shows up as drift.
+	warehouse["size"] = "LARGE"
+	configured.refresh(warehouse)
+	if got := configured.Size.ValueString(); got != "LARGE" {
+		t.Errorf("size = %q, want LARGE", got)
+	}
+}
+
+func TestSnowflakeWarehouseResourceModel_refreshSuspended(t *testing.T) {
+	warehouse := map[string]interface{}{
+		"name":  "ANALYTICS_WH",
+		"state": "SUSPENDED",
+		"type":  "STANDARD",
+	}
+
+	// On import suspended follows the state Snowflake reports.
+	var imported SnowflakeWarehouseResourceModel
+	imported.refresh(warehouse)
+	if !imported.Suspended.ValueBool() {
+		t.Errorf("suspended = %s, want true", imported.Suspended)
+	}
+	if got := imported.WarehouseType.ValueString(); got != "STANDARD" {
+		t.Errorf("warehouse_type = %q, want STANDARD", got)
+	}
+
+	// Afterwards it is the desired state, which auto_suspend does not change.
+	running := SnowflakeWarehouseResourceModel{Suspended: types.BoolValue(false)}
+	running.refresh(warehouse)
+	if running.Suspended.ValueBool() {
+		t.Error("suspended followed the reported state instead of keeping the desired one")
+	}
+
+	if got := warehouseSuspended(map[string]interface{}{"state": "STARTED"}); got.ValueBool() {
+		t.Errorf("warehouseSuspended(STARTED) = %s, want false", got)
+	}
+	if got := warehouseSuspended(map[string]interface{}{}); !got.IsNull() {
+		t.Errorf("warehouseSuspended without a state = %s, want null", got)
+	}
+}
+
+func TestSnowflakeWarehouseResourceModel_validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    SnowflakeWarehouseResourceModel
+		wantErr bool
+	}{
+		{
+			name: "multi-cluster",
+			data: SnowflakeWarehouseResourceModel{
+				MinClusterCount: types.Int64Value(2),
+				MaxClusterCount: types.Int64Value(4),
+				ScalingPolicy:   types.StringValue("ECONOMY"),
+			},
+		},
+		{
+			name: "min above max",
+			data: SnowflakeWarehouseResourceModel{
+				MinClusterCount: types.Int64Value(5),
+				MaxClusterCount: types.Int64Value(2),
+			},
+			wantErr: true,
+		},
+		{
+			name: "scaling policy on a single cluster",
+			data: SnowflakeWarehouseResourceModel{
+				MaxClusterCount: types.Int64Value(1),
+				ScalingPolicy:   types.StringValue("STANDARD"),
+			},
+			wantErr: true,
+		},
+		{
+			// Counts that are unknown or left to Snowflake are checked on
+			// apply instead.
+			name: "unknown max",
+			data: SnowflakeWarehouseResourceModel{
+				MinClusterCount: types.Int64Value(5),
+				MaxClusterCount: types.Int64Unknown(),
+				ScalingPolicy:   types.StringValue("STANDARD"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if diags := tt.data.validate(); diags.HasError() != tt.wantErr {
+				t.Errorf("validate() = %v, wantErr %v", diags, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSnowflakeWarehouseResourceModel_checkEdition(t *testing.T) {
+	multiCluster := SnowflakeWarehouseResourceModel{MaxClusterCount: types.Int64Value(3)}
+	scalingPolicy := SnowflakeWarehouseResourceModel{ScalingPolicy: types.StringValue("ECONOMY")}
+	queryAcceleration := SnowflakeWarehouseResourceModel{EnableQueryAcceleration: types.BoolValue(true)}
+	single := SnowflakeWarehouseResourceModel{
+		MinClusterCount:         types.Int64Value(1),
+		MaxClusterCount:         types.Int64Value(1),
+		EnableQueryAcceleration: types.BoolValue(false),
+	}
+
+	tests := []struct {
+		name    string
+		edition string
+		data    SnowflakeWarehouseResourceModel
+		wantErr bool
+	}{
+		{"multi-cluster on standard", "STANDARD", multiCluster, true},
+		{"scaling policy on standard", "STANDARD", scalingPolicy, true},
+		{"query acceleration on standard", "STANDARD", queryAcceleration, true},
+		{"single cluster on standard", "STANDARD", single, false},
+		{"multi-cluster on enterprise", "ENTERPRISE", multiCluster, false},
+		{"query acceleration on business critical", "BUSINESS_CRITICAL", queryAcceleration, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if diags := tt.data.checkEdition(tt.edition); diags.HasError() != tt.wantErr {
+				t.Errorf("checkEdition(%s) = %v, wantErr %v", tt.edition, diags, tt.wantErr)
+			}
+		})
+	}
+}
+
+// fakeWarehouseAPI serves a single warehouse with OVH ID 1 whose state follows
+// the suspend and resume calls it receives.
+func fakeWarehouseAPI(t *testing.T, state string) (*fakeOVHAPI, *Config) {
+	api, config := newFakeOVHAPI(t, nil)
+	warehouse := map[string]interface{}{"id": "1", "name": "ANALYTICS_WH", "type": "STANDARD", "state": state}
+
+	api.route("GET /cloud/project/snowflake/warehouse/1", func(map[string]interface{}) interface{} {
+		return warehouse
+	})
+	api.route("PUT /cloud/project/snowflake/warehouse/1", func(body map[string]interface{}) interface{} {
+		if body["type"] != nil && warehouse["state"] != "SUSPENDED" {
+			return fakeOVHError(400)
+		}
+		return nil
+	})
+	api.route("POST /cloud/project/snowflake/warehouse/1/suspend", func(map[string]interface{}) interface{} {
+		warehouse["state"] = "SUSPENDED"
+		return nil
+	})
+	api.route("POST /cloud/project/snowflake/warehouse/1/resume", func(map[string]interface{}) interface{} {
+		warehouse["state"] = "STARTED"
+		return nil
+	})
+	return api, config
+}
+
+// updateWarehouse runs Update from state to plan against config.
+func updateWarehouse(t *testing.T, config *Config, state, plan SnowflakeWarehouseResourceModel) *fwresource.UpdateResponse {
+	t.Helper()
+	ctx := context.Background()
+
+	r := &SnowflakeWarehouseResource{config: config}
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	null := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)
+
+	req := fwresource.UpdateRequest{
+		Plan:  tfsdk.Plan{Schema: schemaResp.Schema, Raw: null},
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: null},
+	}
+	if diags := req.Plan.Set(ctx, &plan); diags.HasError() {
+		t.Fatalf("setting plan: %v", diags)
+	}
+	if diags := req.State.Set(ctx, &state); diags.HasError() {
+		t.Fatalf("setting state: %v", diags)
+	}
+
+	resp := &fwresource.UpdateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: null}}
+	r.Update(ctx, req, resp)
+	return resp
+}
+
+func TestSnowflakeWarehouseResource_UpdateSuspendsForTypeChange(t *testing.T) {
+	const (
+		suspend = "POST /cloud/project/snowflake/warehouse/1/suspend"
+		update  = "PUT /cloud/project/snowflake/warehouse/1"
+		resume  = "POST /cloud/project/snowflake/warehouse/1/resume"
+	)
+
+	tests := []struct {
+		name          string
+		state         string
+		wasSuspended  bool
+		wantSuspended bool
+		warehouseType string
+		want          []string
+	}{
+		{"running", "STARTED", false, false, "SNOWPARK-OPTIMIZED", []string{suspend, update, resume}},
+		{"suspended", "SUSPENDED", true, true, "SNOWPARK-OPTIMIZED", []string{update}},
+		{"running and suspending", "STARTED", false, true, "SNOWPARK-OPTIMIZED", []string{suspend, update}},
+		{"suspended and resuming", "SUSPENDED", true, false, "SNOWPARK-OPTIMIZED", []string{update, resume}},
+		{"type unchanged", "STARTED", false, false, "STANDARD", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api, config := fakeWarehouseAPI(t, tt.state)
+
+			state := SnowflakeWarehouseResourceModel{
+				ID:            types.StringValue("1"),
+				Name:          types.StringValue("ANALYTICS_WH"),
+				WarehouseType: types.StringValue("STANDARD"),
+				Suspended:     types.BoolValue(tt.wasSuspended),
+			}
+			plan := state
+			plan.WarehouseType = types.StringValue(tt.warehouseType)
+			plan.Suspended = types.BoolValue(tt.wantSuspended)
+
+			resp := updateWarehouse(t, config, state, plan)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("update failed: %v", resp.Diagnostics)
+			}
+
+			var got []string
+			for _, call := range api.recorded() {
+				if !strings.HasPrefix(call, "GET ") {
+					got = append(got, call)
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("calls = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,282 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// sqlToken is a lexical token of a SQL statement. Unquoted words are stored
+// upper-cased because Snowflake resolves unquoted identifiers and keywords
+// case-insensitively; quoted identifiers and literals are kept verbatim.
+type sqlToken struct {
+	text   string
+	word   bool
+	quoted bool
+	end    int
+}
+
+// tokenizeSQL splits a SQL statement into tokens, dropping whitespace and
+// comments. It is intentionally lenient: unterminated literals run to the end
+// of the input instead of failing.
+func tokenizeSQL(sql string) []sqlToken {
+	var tokens []sqlToken
+
+	for i := 0; i < len(sql); {
+		c := sql[i]
+		switch {
+		case unicode.IsSpace(rune(c)):
+			i++
+		case strings.HasPrefix(sql[i:], "--") || strings.HasPrefix(sql[i:], "//"):
+			end := strings.IndexByte(sql[i:], '\n')
+			if end < 0 {
+				i = len(sql)
+			} else {
+				i += end + 1
+			}
+		case strings.HasPrefix(sql[i:], "/*"):
+			end := strings.Index(sql[i+2:], "*/")
+			if end < 0 {
+				i = len(sql)
+			} else {
+				i += end + 4
+			}
+		case strings.HasPrefix(sql[i:], "$$"):
+			end := strings.Index(sql[i+2:], "$$")
+			stop := len(sql)
+			if end >= 0 {
+				stop = i + end + 4
+			}
+			tokens = append(tokens, sqlToken{text: sql[i:stop], end: stop})
+			i = stop
+		case c == '\'' || c == '"':
+			stop := scanQuoted(sql, i, c)
+			tokens = append(tokens, sqlToken{text: sql[i:stop], word: c == '"', quoted: c == '"', end: stop})
+			i = stop
+		case isSQLWordChar(c):
+			stop := i
+			for stop < len(sql) && isSQLWordChar(sql[stop]) {
+				stop++
+			}
+			tokens = append(tokens, sqlToken{text: strings.ToUpper(sql[i:stop]), word: true, end: stop})
+			i = stop
+		default:
+			tokens = append(tokens, sqlToken{text: string(c), end: i + 1})
+			i++
+		}
+	}
+
+	return tokens
+}
+
+// scanQuoted returns the offset just past the literal or quoted identifier
+// starting at start. A doubled quote character is treated as an escape.
+func scanQuoted(sql string, start int, quote byte) int {
+	for i := start + 1; i < len(sql); i++ {
+		switch sql[i] {
+		case '\\':
+			if quote == '\'' {
+				i++
+			}
+		case quote:
+			if i+1 < len(sql) && sql[i+1] == quote {
+				i++
+				continue
+			}
+			return i + 1
+		}
+	}
+	return len(sql)
+}
+
+func isSQLWordChar(c byte) bool {
+	return c == '_' || c == '$' || c == '@' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+// viewQueryTokens strips a leading CREATE ... VIEW ... AS clause, as found in
+// the text Snowflake reports for views, and returns the tokens of the query.
+// The second return value is the index of the first query token.
+func viewQueryTokens(tokens []sqlToken) ([]sqlToken, int) {
+	if len(tokens) == 0 || tokens[0].text != "CREATE" {
+		return tokens, 0
+	}
+
+	depth := 0
+	seenView := false
+	for i, token := range tokens {
+		switch {
+		case token.text == "(":
+			depth++
+		case token.text == ")":
+			depth--
+		case token.word && !token.quoted && token.text == "VIEW":
+			seenView = true
+		case seenView && depth == 0 && token.word && !token.quoted && token.text == "AS":
+			return tokens[i+1:], i + 1
+		}
+	}
+	return tokens, 0
+}
+
+// normalizeSQL returns a canonical form of a view or query definition so that
+// differences in whitespace, comments, keyword case, trailing semicolons and
+// the CREATE ... AS preamble do not register as changes.
+func normalizeSQL(sql string) string {
+	tokens, _ := viewQueryTokens(tokenizeSQL(sql))
+	for len(tokens) > 0 && tokens[len(tokens)-1].text == ";" {
+		tokens = tokens[:len(tokens)-1]
+	}
+
+	parts := make([]string, len(tokens))
+	for i, token := range tokens {
+		parts[i] = token.text
+	}
+	return strings.Join(parts, " ")
+}
+
+// sqlEquivalent reports whether two SQL texts normalize to the same statement.
+func sqlEquivalent(a, b string) bool {
+	return normalizeSQL(a) == normalizeSQL(b)
+}
+
+// viewQueryText returns the query part of a view text as written, without
+// the CREATE ... AS preamble or trailing semicolons.
+func viewQueryText(text string) string {
+	tokens := tokenizeSQL(text)
+	_, start := viewQueryTokens(tokens)
+	if start == 0 {
+		return strings.TrimRight(strings.TrimSpace(text), "; \t\r\n")
+	}
+	return strings.TrimRight(strings.TrimSpace(text[tokens[start-1].end:]), "; \t\r\n")
+}
+
+// sqlClauseKeywords end a FROM clause when scanning for referenced objects.
+var sqlClauseKeywords = map[string]bool{
+	"WHERE": true, "GROUP": true, "HAVING": true, "QUALIFY": true, "ORDER": true,
+	"LIMIT": true, "UNION": true, "INTERSECT": true, "EXCEPT": true, "MINUS": true,
+	"WINDOW": true, "SELECT": true,
+}
+
+// sqlReferencedObjects returns the distinct objects a query reads from, as
+// they are named in its FROM and JOIN clauses. Common table expressions and
+// table functions are excluded, as is the FROM of function calls such as
+// EXTRACT(YEAR FROM ts) or TRIM(BOTH ' ' FROM name).
+func sqlReferencedObjects(sql string) []string {
+	tokens, _ := viewQueryTokens(tokenizeSQL(sql))
+
+	cteNames := map[string]bool{}
+	for i := 0; i+2 < len(tokens); i++ {
+		if tokens[i].word && tokens[i+1].text == "AS" && tokens[i+2].text == "(" &&
+			(i > 0 && (tokens[i-1].text == "WITH" || tokens[i-1].text == "," || tokens[i-1].text == "RECURSIVE")) {
+			cteNames[tokens[i].text] = true
+		}
+	}
+
+	var references []string
+	seen := map[string]bool{}
+	expectObject := false
+	depth := 0
+	inFrom := map[int]bool{}
+	// query records which parenthesis depths hold a query or subquery rather
+	// than an expression, whose FROM does not name a table source.
+	query := map[int]bool{0: true}
+
+	for i := 0; i < len(tokens); i++ {
+		token := tokens[i]
+
+		switch {
+		case token.text == "(":
+			depth++
+			inFrom[depth] = false
+			query[depth] = i+1 < len(tokens) && !tokens[i+1].quoted &&
+				(tokens[i+1].text == "SELECT" || tokens[i+1].text == "WITH")
+		case token.text == ")":
+			depth--
+		case !query[depth]:
+			continue
+		case token.word && !token.quoted && (token.text == "FROM" || token.text == "JOIN"):
+			expectObject = true
+			inFrom[depth] = true
+			continue
+		case token.text == "," && inFrom[depth]:
+			expectObject = true
+			continue
+		case token.word && !token.quoted && sqlClauseKeywords[token.text]:
+			inFrom[depth] = false
+		}
+
+		if !expectObject {
+			continue
+		}
+		expectObject = false
+
+		if !token.word {
+			continue
+		}
+		if !token.quoted && token.text == "LATERAL" {
+			expectObject = true
+			continue
+		}
+
+		// Join dotted parts into a single qualified name.
+		name := token.text
+		for i+2 < len(tokens) && tokens[i+1].text == "." && tokens[i+2].word {
+			name += "." + tokens[i+2].text
+			i += 2
+		}
+
+		// Table functions such as TABLE(...) or FLATTEN(...) are not objects.
+		if i+1 < len(tokens) && tokens[i+1].text == "(" {
+			continue
+		}
+		if cteNames[name] || seen[name] {
+			continue
+		}
+		seen[name] = true
+		references = append(references, name)
+	}
+
+	return references
+}
+
+// modifyViewPlan plans the columns and referenced_objects of a view or
+// materialized view on update. Columns the configuration leaves out keep
+// their prior names, and referenced_objects follows the statement, unless a
+// new statement may change them.
+func modifyViewPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var configColumns, stateColumns types.List
+	var planStatement, stateStatement SQLStatementValue
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("columns"), &configColumns)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("columns"), &stateColumns)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("statement"), &planStatement)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("statement"), &stateStatement)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	statementChanged := planStatement.IsUnknown() || !sqlEquivalent(planStatement.ValueString(), stateStatement.ValueString())
+	if configColumns.IsNull() {
+		columns, diags := planViewColumns(ctx, configColumns, stateColumns, statementChanged)
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("columns"), columns)...)
+	}
+
+	if statementChanged {
+		references := types.ListUnknown(types.StringType)
+		if !planStatement.IsUnknown() {
+			var diags diag.Diagnostics
+			references, diags = referencedObjectsValue(ctx, planStatement.ValueString())
+			resp.Diagnostics.Append(diags...)
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("referenced_objects"), references)...)
+	}
+}
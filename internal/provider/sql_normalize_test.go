@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNormalizeSQL_Equivalent(t *testing.T) {
+	testCases := map[string]struct {
+		a, b string
+	}{
+		"whitespace": {
+			a: "select id, name from orders where status = 'open'",
+			b: "SELECT id,\n       name\n  FROM orders\n WHERE status = 'open'",
+		},
+		"keyword_case": {
+			a: "select * from analytics.public.orders",
+			b: "SELECT * FROM ANALYTICS.PUBLIC.ORDERS",
+		},
+		"comments_and_semicolon": {
+			a: "select 1 -- trailing comment\n;",
+			b: "/* header */ SELECT 1",
+		},
+		"create_preamble": {
+			a: "select id from orders",
+			b: "CREATE OR REPLACE SECURE VIEW DB.SCH.V (ID COMMENT 'key') COMMENT = 'x' AS\nselect id from orders;",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if !sqlEquivalent(tc.a, tc.b) {
+				t.Errorf("expected statements to be equivalent:\n%s\n%s", normalizeSQL(tc.a), normalizeSQL(tc.b))
+			}
+		})
+	}
+}
+
+func TestNormalizeSQL_Different(t *testing.T) {
+	testCases := map[string]struct {
+		a, b string
+	}{
+		"string_literal_case": {
+			a: "select * from orders where status = 'open'",
+			b: "select * from orders where status = 'OPEN'",
+		},
+		"quoted_identifier_case": {
+			a: `select "id" from orders`,
+			b: `select "ID" from orders`,
+		},
+		"different_table": {
+			a: "select * from orders",
+			b: "select * from order_lines",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if sqlEquivalent(tc.a, tc.b) {
+				t.Errorf("expected statements to differ: %s", normalizeSQL(tc.a))
+			}
+		})
+	}
+}
+
+func TestViewQueryText(t *testing.T) {
+	text := "CREATE OR REPLACE VIEW V AS\n  select id\n  from orders;\n"
+	expected := "select id\n  from orders"
+
+	if got := viewQueryText(text); got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestSQLReferencedObjects(t *testing.T) {
+	statement := `
+with recent as (select * from raw.events where ts > dateadd(day, -1, current_timestamp()))
+select o.id, c.name
+from analytics.public.orders o
+join customers c on c.id = o.customer_id
+left join recent r on r.order_id = o.id, "Mixed"."Case" m, lateral flatten(input => o.items)
+where o.id in (select order_id from returns)`
+
+	expected := []string{"RAW.EVENTS", "ANALYTICS.PUBLIC.ORDERS", "CUSTOMERS", `"Mixed"."Case"`, "RETURNS"}
+
+	if got := sqlReferencedObjects(statement); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestSQLReferencedObjects_FunctionFrom(t *testing.T) {
+	testCases := map[string]struct {
+		statement string
+		expected  []string
+	}{
+		"extract": {
+			statement: "SELECT EXTRACT(YEAR FROM created_at) AS y FROM db.s.orders",
+			expected:  []string{"DB.S.ORDERS"},
+		},
+		"trim": {
+			statement: "SELECT TRIM(BOTH ' ' FROM name) FROM db.s.t",
+			expected:  []string{"DB.S.T"},
+		},
+		"substring": {
+			statement: "SELECT SUBSTRING(code FROM 2 FOR 3) FROM db.s.codes",
+			expected:  []string{"DB.S.CODES"},
+		},
+		"function_in_subquery": {
+			statement: "SELECT * FROM (SELECT EXTRACT(MONTH FROM ts) AS m FROM db.s.events) e",
+			expected:  []string{"DB.S.EVENTS"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := sqlReferencedObjects(tc.statement); !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable                    = SQLStatementType{}
+	_ basetypes.StringValuableWithSemanticEquals = SQLStatementValue{}
+)
+
+// SQLStatementType is a string type for SQL definitions. Values that only
+// differ in formatting are semantically equal, so the text Snowflake reports
+// back for an object does not overwrite the configured statement.
+type SQLStatementType struct {
+	basetypes.StringType
+}
+
+func (t SQLStatementType) String() string {
+	return "SQLStatementType"
+}
+
+func (t SQLStatementType) ValueType(ctx context.Context) attr.Value {
+	return SQLStatementValue{}
+}
+
+func (t SQLStatementType) Equal(o attr.Type) bool {
+	other, ok := o.(SQLStatementType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t SQLStatementType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return SQLStatementValue{StringValue: in}, nil
+}
+
+func (t SQLStatementType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	return SQLStatementValue{StringValue: stringValue}, nil
+}
+
+// SQLStatementValue is the value type of SQLStatementType.
+type SQLStatementValue struct {
+	basetypes.StringValue
+}
+
+// NewSQLStatementValue returns a known SQL statement value.
+func NewSQLStatementValue(value string) SQLStatementValue {
+	return SQLStatementValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+// NewSQLStatementNull returns a null SQL statement value.
+func NewSQLStatementNull() SQLStatementValue {
+	return SQLStatementValue{StringValue: basetypes.NewStringNull()}
+}
+
+func (v SQLStatementValue) Type(ctx context.Context) attr.Type {
+	return SQLStatementType{}
+}
+
+func (v SQLStatementValue) Equal(o attr.Value) bool {
+	other, ok := o.(SQLStatementValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v SQLStatementValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(SQLStatementValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T, got: %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	return sqlEquivalent(v.ValueString(), newValue.ValueString()), diags
+}
@@ -0,0 +1,260 @@
+// Package identifiers parses, validates and formats Snowflake object
+// identifiers.
+//
+// Names held by the identifier types are the names Snowflake stores: an
+// unquoted identifier written as analytics is stored as ANALYTICS, while a
+// quoted identifier written as "analytics" keeps its case. Formatting an
+// identifier quotes exactly the parts that need it, so that parsing the
+// result gives back the same names.
+package identifiers
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// MaxNameLength is the maximum number of characters in a Snowflake name.
+const MaxNameLength = 255
+
+// unquotedNamePattern matches names that Snowflake resolves to themselves
+// without quoting: unquoted identifiers are stored upper-case, so anything with
+// lower-case letters or special characters needs quotes.
+var unquotedNamePattern = regexp.MustCompile(`^[A-Z_][A-Z0-9_$]*$`)
+
+// unquotedPartPattern matches an unquoted identifier as written in SQL, in any
+// case.
+var unquotedPartPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_$]*$`)
+
+// AccountObjectIdentifier identifies an object that lives directly in the
+// account, such as a warehouse, database, role or user.
+type AccountObjectIdentifier struct {
+	name string
+}
+
+// NewAccountObjectIdentifier returns the identifier of the named account
+// object.
+func NewAccountObjectIdentifier(name string) AccountObjectIdentifier {
+	return AccountObjectIdentifier{name: name}
+}
+
+// ParseAccountObjectIdentifier parses an identifier such as ANALYTICS_WH or
+// "Analytics WH".
+func ParseAccountObjectIdentifier(identifier string) (AccountObjectIdentifier, error) {
+	parts, err := parseParts(identifier, 1)
+	if err != nil {
+		return AccountObjectIdentifier{}, err
+	}
+	return NewAccountObjectIdentifier(parts[0]), nil
+}
+
+func (i AccountObjectIdentifier) Name() string {
+	return i.name
+}
+
+// FullyQualifiedName returns the identifier as it is written in SQL.
+func (i AccountObjectIdentifier) FullyQualifiedName() string {
+	return FormatName(i.name)
+}
+
+func (i AccountObjectIdentifier) String() string {
+	return i.FullyQualifiedName()
+}
+
+// DatabaseObjectIdentifier identifies an object that lives in a database,
+// such as a schema.
+type DatabaseObjectIdentifier struct {
+	databaseName string
+	name         string
+}
+
+// NewDatabaseObjectIdentifier returns the identifier of the named object in
+// the database.
+func NewDatabaseObjectIdentifier(databaseName, name string) DatabaseObjectIdentifier {
+	return DatabaseObjectIdentifier{databaseName: databaseName, name: name}
+}
+
+// ParseDatabaseObjectIdentifier parses an identifier of the form
+// database.name.
+func ParseDatabaseObjectIdentifier(identifier string) (DatabaseObjectIdentifier, error) {
+	parts, err := parseParts(identifier, 2)
+	if err != nil {
+		return DatabaseObjectIdentifier{}, err
+	}
+	return NewDatabaseObjectIdentifier(parts[0], parts[1]), nil
+}
+
+func (i DatabaseObjectIdentifier) DatabaseName() string {
+	return i.databaseName
+}
+
+func (i DatabaseObjectIdentifier) Name() string {
+	return i.name
+}
+
+// DatabaseIdentifier returns the identifier of the database containing the
+// object.
+func (i DatabaseObjectIdentifier) DatabaseIdentifier() AccountObjectIdentifier {
+	return NewAccountObjectIdentifier(i.databaseName)
+}
+
+// FullyQualifiedName returns the identifier as it is written in SQL.
+func (i DatabaseObjectIdentifier) FullyQualifiedName() string {
+	return FormatName(i.databaseName) + "." + FormatName(i.name)
+}
+
+func (i DatabaseObjectIdentifier) String() string {
+	return i.FullyQualifiedName()
+}
+
+// SchemaObjectIdentifier identifies an object that lives in a schema, such as
+// a table, view or policy.
+type SchemaObjectIdentifier struct {
+	databaseName string
+	schemaName   string
+	name         string
+}
+
+// NewSchemaObjectIdentifier returns the identifier of the named object in the
+// schema.
+func NewSchemaObjectIdentifier(databaseName, schemaName, name string) SchemaObjectIdentifier {
+	return SchemaObjectIdentifier{databaseName: databaseName, schemaName: schemaName, name: name}
+}
+
+// ParseSchemaObjectIdentifier parses an identifier of the form
+// database.schema.name.
+func ParseSchemaObjectIdentifier(identifier string) (SchemaObjectIdentifier, error) {
+	parts, err := parseParts(identifier, 3)
+	if err != nil {
+		return SchemaObjectIdentifier{}, err
+	}
+	return NewSchemaObjectIdentifier(parts[0], parts[1], parts[2]), nil
+}
+
+func (i SchemaObjectIdentifier) DatabaseName() string {
+	return i.databaseName
+}
+
+func (i SchemaObjectIdentifier) SchemaName() string {
+	return i.schemaName
+}
+
+func (i SchemaObjectIdentifier) Name() string {
+	return i.name
+}
+
+// SchemaIdentifier returns the identifier of the schema containing the
+// object.
+func (i SchemaObjectIdentifier) SchemaIdentifier() DatabaseObjectIdentifier {
+	return NewDatabaseObjectIdentifier(i.databaseName, i.schemaName)
+}
+
+// FullyQualifiedName returns the identifier as it is written in SQL.
+func (i SchemaObjectIdentifier) FullyQualifiedName() string {
+	return FormatName(i.databaseName) + "." + FormatName(i.schemaName) + "." + FormatName(i.name)
+}
+
+func (i SchemaObjectIdentifier) String() string {
+	return i.FullyQualifiedName()
+}
+
+// Quote returns name as a double-quoted identifier, escaping embedded double
+// quotes.
+func Quote(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+// FormatName returns name in the form that refers to exactly that name in SQL:
+// names that Snowflake would resolve unchanged are left bare and all others
+// are quoted.
+func FormatName(name string) string {
+	if unquotedNamePattern.MatchString(name) {
+		return name
+	}
+	return Quote(name)
+}
+
+// ValidateName returns an error when name cannot be used as a Snowflake name.
+func ValidateName(name string) error {
+	if name == "" {
+		return fmt.Errorf("name cannot be empty")
+	}
+	if n := len([]rune(name)); n > MaxNameLength {
+		return fmt.Errorf("name %q is %d characters long, the maximum is %d", name, n, MaxNameLength)
+	}
+	return nil
+}
+
+// ParseIdentifier splits a dotted identifier of up to three parts into the
+// names it refers to. Unquoted parts are upper-cased as Snowflake does, while
+// quoted parts keep their case and have escaped double quotes unescaped.
+func ParseIdentifier(identifier string) ([]string, error) {
+	var parts []string
+
+	for i := 0; ; {
+		var part string
+		if i < len(identifier) && identifier[i] == '"' {
+			var b strings.Builder
+			closed := false
+			for i++; i < len(identifier); i++ {
+				if identifier[i] != '"' {
+					b.WriteByte(identifier[i])
+					continue
+				}
+				if i+1 < len(identifier) && identifier[i+1] == '"' {
+					b.WriteByte('"')
+					i++
+					continue
+				}
+				closed = true
+				i++
+				break
+			}
+			if !closed {
+				return nil, fmt.Errorf("identifier %q has an unterminated quoted part", identifier)
+			}
+			part = b.String()
+			if i < len(identifier) && identifier[i] != '.' {
+				return nil, fmt.Errorf("identifier %q has unexpected characters after a quoted part", identifier)
+			}
+		} else {
+			end := strings.IndexByte(identifier[i:], '.')
+			if end < 0 {
+				end = len(identifier) - i
+			}
+			raw := identifier[i : i+end]
+			if raw != "" && !unquotedPartPattern.MatchString(raw) {
+				return nil, fmt.Errorf("identifier %q has an invalid part %q; quote parts that contain special characters", identifier, raw)
+			}
+			part = strings.ToUpper(raw)
+			i += end
+		}
+
+		if err := ValidateName(part); err != nil {
+			return nil, fmt.Errorf("identifier %q is invalid: %w", identifier, err)
+		}
+
+		parts = append(parts, part)
+		if len(parts) > 3 {
+			return nil, fmt.Errorf("identifier %q has more than 3 parts", identifier)
+		}
+
+		if i >= len(identifier) {
+			return parts, nil
+		}
+		// Skip the dot separating this part from the next one.
+		i++
+	}
+}
+
+// parseParts parses an identifier that must have exactly count parts.
+func parseParts(identifier string, count int) ([]string, error) {
+	parts, err := ParseIdentifier(identifier)
+	if err != nil {
+		return nil, err
+	}
+	if len(parts) != count {
+		return nil, fmt.Errorf("identifier %q must have %d dot-separated parts, got %d", identifier, count, len(parts))
+	}
+	return parts, nil
+}
@@ -0,0 +1,179 @@
+package identifiers
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFormatName(t *testing.T) {
+	tests := map[string]string{
+		"ANALYTICS":   "ANALYTICS",
+		"RAW$DATA":    "RAW$DATA",
+		"_STAGING":    "_STAGING",
+		"analytics":   `"analytics"`,
+		"Events":      `"Events"`,
+		"order items": `"order items"`,
+		"1DB":         `"1DB"`,
+		`say "hi"`:    `"say ""hi"""`,
+	}
+
+	for name, want := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := FormatName(name); got != want {
+				t.Errorf("FormatName(%q) = %s, want %s", name, got, want)
+			}
+		})
+	}
+}
+
+func TestParseIdentifier(t *testing.T) {
+	tests := []struct {
+		identifier string
+		want       []string
+		wantErr    bool
+	}{
+		{"analytics.public.events", []string{"ANALYTICS", "PUBLIC", "EVENTS"}, false},
+		{`analytics.public."Events"`, []string{"ANALYTICS", "PUBLIC", "Events"}, false},
+		{`"my.db"."raw schema"`, []string{"my.db", "raw schema"}, false},
+		{`"say ""hi"""`, []string{`say "hi"`}, false},
+		{"WH_1", []string{"WH_1"}, false},
+		{"", nil, true},
+		{"a..b", nil, true},
+		{"a.b.", nil, true},
+		{"a.b.c.d", nil, true},
+		{`"unterminated`, nil, true},
+		{`"quoted"x.b`, nil, true},
+		{`""`, nil, true},
+		{"order items", nil, true},
+		{"1db.public", nil, true},
+		{strings.Repeat("A", MaxNameLength+1), nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.identifier, func(t *testing.T) {
+			got, err := ParseIdentifier(tt.identifier)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseIdentifier(%q) error = %v, wantErr %v", tt.identifier, err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseIdentifier(%q) = %q, want %q", tt.identifier, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIdentifiers_RoundTrip(t *testing.T) {
+	for _, id := range []AccountObjectIdentifier{
+		NewAccountObjectIdentifier("ANALYTICS_WH"),
+		NewAccountObjectIdentifier("analytics wh"),
+		NewAccountObjectIdentifier(`say "hi"`),
+	} {
+		got, err := ParseAccountObjectIdentifier(id.FullyQualifiedName())
+		if err != nil {
+			t.Fatalf("ParseAccountObjectIdentifier(%s): %s", id, err)
+		}
+		if got != id {
+			t.Errorf("ParseAccountObjectIdentifier(%s) = %#v, want %#v", id, got, id)
+		}
+	}
+
+	for _, id := range []DatabaseObjectIdentifier{
+		NewDatabaseObjectIdentifier("ANALYTICS", "PUBLIC"),
+		NewDatabaseObjectIdentifier("analytics", "Raw.Data"),
+	} {
+		got, err := ParseDatabaseObjectIdentifier(id.FullyQualifiedName())
+		if err != nil {
+			t.Fatalf("ParseDatabaseObjectIdentifier(%s): %s", id, err)
+		}
+		if got != id {
+			t.Errorf("ParseDatabaseObjectIdentifier(%s) = %#v, want %#v", id, got, id)
+		}
+	}
+
+	for _, id := range []SchemaObjectIdentifier{
+		NewSchemaObjectIdentifier("ANALYTICS", "PUBLIC", "EVENTS"),
+		NewSchemaObjectIdentifier("analytics", "Public", "order items"),
+	} {
+		got, err := ParseSchemaObjectIdentifier(id.FullyQualifiedName())
+		if err != nil {
+			t.Fatalf("ParseSchemaObjectIdentifier(%s): %s", id, err)
+		}
+		if got != id {
+			t.Errorf("ParseSchemaObjectIdentifier(%s) = %#v, want %#v", id, got, id)
+		}
+		if got.SchemaIdentifier().DatabaseIdentifier().Name() != id.DatabaseName() {
+			t.Errorf("unexpected database of %s", id)
+		}
+	}
+}
+
+func TestParse_WrongPartCount(t *testing.T) {
+	if _, err := ParseAccountObjectIdentifier("ANALYTICS.PUBLIC"); err == nil {
+		t.Error("expected an error for a two-part account object identifier")
+	}
+	if _, err := ParseDatabaseObjectIdentifier("ANALYTICS"); err == nil {
+		t.Error("expected an error for a one-part database object identifier")
+	}
+	if _, err := ParseSchemaObjectIdentifier("ANALYTICS.PUBLIC"); err == nil {
+		t.Error("expected an error for a two-part schema object identifier")
+	}
+}
+
+func TestValidators(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		validator validator.String
+		value     types.String
+		wantErr   bool
+	}{
+		{"name", ValidName(), types.StringValue("analytics wh"), false},
+		{"name_empty", ValidName(), types.StringValue(""), true},
+		{"name_too_long", ValidName(), types.StringValue(strings.Repeat("a", MaxNameLength+1)), true},
+		{"name_null", ValidName(), types.StringNull(), false},
+		{"account", ValidAccountObjectIdentifier(), types.StringValue(`"Analytics WH"`), false},
+		{"account_unquoted_space", ValidAccountObjectIdentifier(), types.StringValue("Analytics WH"), true},
+		{"database", ValidDatabaseObjectIdentifier(), types.StringValue("analytics.public"), false},
+		{"database_too_many_parts", ValidDatabaseObjectIdentifier(), types.StringValue("analytics.public.events"), true},
+		{"schema", ValidSchemaObjectIdentifier(), types.StringValue(`analytics.public."Events"`), false},
+		{"schema_unknown", ValidSchemaObjectIdentifier(), types.StringUnknown(), false},
+		{"schema_too_few_parts", ValidSchemaObjectIdentifier(), types.StringValue("analytics.public"), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &validator.StringResponse{}
+			tt.validator.ValidateString(ctx, validator.StringRequest{Path: path.Root("name"), ConfigValue: tt.value}, resp)
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Errorf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestValidateNameFunc(t *testing.T) {
+	tests := []struct {
+		value   interface{}
+		wantErr bool
+	}{
+		{"analytics wh", false},
+		{`"Events"`, false},
+		{"", true},
+		{strings.Repeat("a", MaxNameLength+1), true},
+		{42, true},
+	}
+
+	for _, tt := range tests {
+		_, errs := ValidateNameFunc(tt.value, "name")
+		if (len(errs) > 0) != tt.wantErr {
+			t.Errorf("ValidateNameFunc(%v) errors = %v, wantErr %v", tt.value, errs, tt.wantErr)
+		}
+	}
+}
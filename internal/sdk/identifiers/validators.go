@@ -0,0 +1,97 @@
+package identifiers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// ValidName returns a validator for attributes holding a single Snowflake
+// name, such as the name of a warehouse or table. The name is used as is, so
+// it may contain any character.
+func ValidName() validator.String {
+	return nameValidator{}
+}
+
+// ValidAccountObjectIdentifier returns a validator for attributes holding an
+// identifier such as ANALYTICS_WH or "Analytics WH".
+func ValidAccountObjectIdentifier() validator.String {
+	return identifierValidator{parts: 1, format: "name"}
+}
+
+// ValidDatabaseObjectIdentifier returns a validator for attributes holding an
+// identifier of the form database.name.
+func ValidDatabaseObjectIdentifier() validator.String {
+	return identifierValidator{parts: 2, format: "database.name"}
+}
+
+// ValidSchemaObjectIdentifier returns a validator for attributes holding an
+// identifier of the form database.schema.name.
+func ValidSchemaObjectIdentifier() validator.String {
+	return identifierValidator{parts: 3, format: "database.schema.name"}
+}
+
+// ValidateNameFunc is the SDKv2 counterpart of ValidName, for use as the
+// ValidateFunc of a schema.Schema.
+func ValidateNameFunc(v interface{}, k string) ([]string, []error) {
+	name, ok := v.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected %s to be a string", k)}
+	}
+	if err := ValidateName(name); err != nil {
+		return nil, []error{fmt.Errorf("%s: %w", k, err)}
+	}
+	return nil, nil
+}
+
+type nameValidator struct{}
+
+func (v nameValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be a Snowflake name of 1 to %d characters", MaxNameLength)
+}
+
+func (v nameValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v nameValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := ValidateName(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Snowflake Name",
+			fmt.Sprintf("%s.", err),
+		)
+	}
+}
+
+type identifierValidator struct {
+	parts  int
+	format string
+}
+
+func (v identifierValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be a Snowflake identifier of the form %s, with parts containing special characters double-quoted", v.format)
+}
+
+func (v identifierValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v identifierValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := parseParts(req.ConfigValue.ValueString(), v.parts); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Snowflake Identifier",
+			fmt.Sprintf("%s. Expected an identifier of the form %s.", err, v.format),
+		)
+	}
+}